@@ -0,0 +1,299 @@
+package graph
+
+import (
+	"fmt"
+	"math/rand"
+	"runtime"
+	"sort"
+	"sync"
+
+	"github.com/subpop/go-adt"
+)
+
+// A BetweennessOption configures BetweennessCentrality and EdgeBetweenness.
+type BetweennessOption func(*betweennessConfig)
+
+type betweennessConfig struct {
+	samples int
+	seed    int64
+	workers int
+}
+
+// BetweennessSamples approximates betweenness by accumulating shortest paths
+// from k source vertices (pivots) chosen uniformly at random, instead of from
+// every vertex, and scaling the result by n/k. The pivots are drawn from a
+// pseudo-random generator seeded with seed, so the same seed on the same graph
+// gives the same result. If k is not less than the number of vertices, the
+// exact value is computed.
+func BetweennessSamples(k int, seed int64) BetweennessOption {
+	return func(c *betweennessConfig) {
+		c.samples = k
+		c.seed = seed
+	}
+}
+
+// BetweennessWorkers sets the number of goroutines that process source
+// vertices concurrently. The default, also used if n is less than 1, is
+// runtime.GOMAXPROCS(0).
+func BetweennessWorkers(n int) BetweennessOption {
+	return func(c *betweennessConfig) {
+		c.workers = n
+	}
+}
+
+// BetweennessCentrality returns, for every vertex v, the fraction of shortest
+// paths between pairs of other vertices that pass through v, computed with
+// Brandes' algorithm. Scores are normalized by (n-1)(n-2), the number of
+// ordered pairs of other vertices, so they range from 0 to 1. Paths are
+// measured in hops if all edges have the same weight, and by weight otherwise.
+// Edge weights other than those of self-loops must be positive, since a
+// zero-weight cycle would give a pair infinitely many shortest walks. If an
+// edge weight is not positive, or BetweennessSamples is given a negative k,
+// it returns InvalidArgumentErr.
+func (g *Graph[V]) BetweennessCentrality(opts ...BetweennessOption) (map[V]float64, error) {
+	vertexScores, _, err := g.brandes(opts, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return vertexScores, nil
+}
+
+// EdgeBetweenness returns, for every edge, the fraction of shortest paths
+// between pairs of vertices that traverse it, computed with Brandes' algorithm
+// and normalized by n(n-1). Each edge of an undirected graph appears once in
+// the returned map, oriented as in GetAllEdges. Paths are measured as in
+// BetweennessCentrality, and the same options apply.
+func (g *Graph[V]) EdgeBetweenness(opts ...BetweennessOption) (map[Edge[V]]float64, error) {
+	_, edgeScores, err := g.brandes(opts, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return edgeScores, nil
+}
+
+// brandes accumulates pair dependencies from each source vertex, spreading the
+// sources over a pool of goroutines that each keep their own partial sums.
+func (g *Graph[V]) brandes(opts []BetweennessOption, edges bool) (map[V]float64, map[Edge[V]]float64, error) {
+	config := betweennessConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.samples < 0 {
+		return nil, nil, InvalidArgumentErr{fmt.Sprintf("%v", config.samples), "number of samples must be non-negative"}
+	}
+	if config.workers < 1 {
+		config.workers = runtime.GOMAXPROCS(0)
+	}
+	for u, e := range g.adjacencyMap {
+		for v, weight := range e.Explicit {
+			if u != v && !(weight > 0) {
+				return nil, nil, InvalidArgumentErr{fmt.Sprintf("%v", weight), "edge weights must be positive"}
+			}
+		}
+	}
+
+	sources := g.sortedVertices()
+	n := len(sources)
+	scale := 1.0
+	if config.samples > 0 && config.samples < n {
+		r := rand.New(rand.NewSource(config.seed))
+		r.Shuffle(n, func(i, j int) { sources[i], sources[j] = sources[j], sources[i] })
+		sources = sources[:config.samples]
+		scale = float64(n) / float64(config.samples)
+	}
+
+	weighted := g.isWeighted()
+	vertexScores := make(map[V]float64, n)
+	for v := range g.vertices {
+		vertexScores[v] = 0
+	}
+	// Workers key their edge scores by the ordered pair they traverse, and
+	// canonical maps each pair to its key in the result once, so that
+	// undirected keys are not rebuilt in the inner loop.
+	var edgeScores map[Edge[V]]float64
+	var canonical map[Edge[V]]Edge[V]
+	if edges {
+		edgeScores = make(map[Edge[V]]float64)
+		canonical = make(map[Edge[V]]Edge[V])
+		for u, e := range g.adjacencyMap {
+			for v := range e.Explicit {
+				if u != v {
+					key := g.edgeKey(u, v)
+					canonical[Edge[V]{From: u, To: v}] = key
+					edgeScores[key] = 0
+				}
+			}
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan V)
+	for i := 0; i < config.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			localVertices := make(map[V]float64)
+			localEdges := make(map[Edge[V]]float64)
+			for s := range queue {
+				g.accumulateDependencies(s, weighted, localVertices, localEdges, edges)
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for v, score := range localVertices {
+				vertexScores[v] += score
+			}
+			for e, score := range localEdges {
+				edgeScores[canonical[e]] += score
+			}
+		}()
+	}
+	for _, s := range sources {
+		queue <- s
+	}
+	close(queue)
+	wg.Wait()
+
+	// Normalize by the number of ordered pairs. An undirected graph counts
+	// each unordered pair once from either endpoint, so it needs no separate
+	// halving except in the degenerate case where there are no pairs to
+	// normalize by.
+	vertexScale := scale
+	if n > 2 {
+		vertexScale /= float64((n - 1) * (n - 2))
+	} else if !g.isDirected {
+		vertexScale /= 2
+	}
+	for v := range vertexScores {
+		vertexScores[v] *= vertexScale
+	}
+
+	edgeScale := scale
+	if n > 1 {
+		edgeScale /= float64(n * (n - 1))
+	}
+	for e := range edgeScores {
+		edgeScores[e] *= edgeScale
+	}
+
+	return vertexScores, edgeScores, nil
+}
+
+// accumulateDependencies runs a single-source shortest path search from s,
+// counting the shortest paths to every vertex, and then adds the dependency of
+// s on each vertex (and, if edges is true, on each edge, keyed by the ordered
+// pair of its endpoints as traversed) to the given maps.
+func (g *Graph[V]) accumulateDependencies(s V, weighted bool, vertexScores map[V]float64, edgeScores map[Edge[V]]float64, edges bool) {
+	var order []V
+	var preds map[V][]V
+	var sigma map[V]float64
+	if weighted {
+		order, preds, sigma = g.countShortestPathsDijkstra(s)
+	} else {
+		order, preds, sigma = g.countShortestPathsBFS(s)
+	}
+
+	delta := make(map[V]float64, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		w := order[i]
+		for _, v := range preds[w] {
+			c := sigma[v] / sigma[w] * (1 + delta[w])
+			delta[v] += c
+			if edges {
+				edgeScores[Edge[V]{From: v, To: w}] += c
+			}
+		}
+		if w != s {
+			vertexScores[w] += delta[w]
+		}
+	}
+}
+
+// countShortestPathsBFS returns the vertices reachable from s in order of
+// non-decreasing hop distance, the predecessors of each vertex on shortest
+// paths from s, and the number of shortest paths from s to each vertex.
+func (g *Graph[V]) countShortestPathsBFS(s V) ([]V, map[V][]V, map[V]float64) {
+	order := make([]V, 0)
+	preds := make(map[V][]V)
+	sigma := map[V]float64{s: 1}
+	dist := map[V]int{s: 0}
+
+	queue := adt.NewQueue[V]()
+	_ = queue.Enqueue(s)
+	for queue.Len() > 0 {
+		u := *queue.Dequeue()
+		order = append(order, u)
+		for v := range g.adjacencyMap[u].Explicit {
+			if v == u {
+				continue
+			}
+			if _, seen := dist[v]; !seen {
+				dist[v] = dist[u] + 1
+				_ = queue.Enqueue(v)
+			}
+			if dist[v] == dist[u]+1 {
+				sigma[v] += sigma[u]
+				preds[v] = append(preds[v], u)
+			}
+		}
+	}
+
+	return order, preds, sigma
+}
+
+// countShortestPathsDijkstra is the weighted counterpart of
+// countShortestPathsBFS.
+func (g *Graph[V]) countShortestPathsDijkstra(s V) ([]V, map[V][]V, map[V]float64) {
+	order := make([]V, 0)
+	preds := make(map[V][]V)
+	sigma := map[V]float64{s: 1}
+	dist := map[V]float64{s: 0}
+	settled := make(map[V]bool)
+
+	pq := adt.NewPriorityQueue[V](0)
+	pq.Push(s, 0)
+	for pq.Len() > 0 {
+		u := *pq.Pop()
+		if settled[u] {
+			continue
+		}
+		settled[u] = true
+		order = append(order, u)
+
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			if v == u || settled[v] {
+				continue
+			}
+			alt := dist[u] + weight
+			if d, seen := dist[v]; !seen || alt < d {
+				dist[v] = alt
+				sigma[v] = sigma[u]
+				preds[v] = []V{u}
+				pq.Push(v, alt)
+			} else if alt == d {
+				sigma[v] += sigma[u]
+				preds[v] = append(preds[v], u)
+			}
+		}
+	}
+
+	return order, preds, sigma
+}
+
+// sortedVertices returns the vertices of the graph ordered by their string
+// representation, giving algorithms that draw random samples a stable order
+// to draw from.
+func (g *Graph[V]) sortedVertices() []V {
+	vertices := g.GetAllVertices()
+	keys := make(map[V]string, len(vertices))
+	for _, v := range vertices {
+		keys[v] = fmt.Sprintf("%v", v)
+	}
+	sort.Slice(vertices, func(i, j int) bool {
+		return keys[vertices[i]] < keys[vertices[j]]
+	})
+
+	return vertices
+}
@@ -0,0 +1,164 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// barbellGraph joins two triangles (1, 2, 3) and (4, 5, 6) by the bridge
+// (3, 4).
+func barbellGraph() Graph[int] {
+	return edgeListGraph(false,
+		[2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1},
+		[2]int{4, 5}, [2]int{5, 6}, [2]int{6, 4},
+		[2]int{3, 4},
+	)
+}
+
+func TestBetweennessCentrality(t *testing.T) {
+	weighted := NewGraph[string](false)
+	_ = weighted.AddEdge("a", "b", 1)
+	_ = weighted.AddEdge("b", "c", 1)
+	_ = weighted.AddEdge("a", "c", 5)
+
+	zeroWeightSquare := NewGraph[string](false)
+	_ = zeroWeightSquare.AddEdge("a", "b", 0)
+	_ = zeroWeightSquare.AddEdge("b", "c", 1)
+	_ = zeroWeightSquare.AddEdge("a", "d", 1)
+	_ = zeroWeightSquare.AddEdge("d", "c", 0)
+
+	tests := []struct {
+		description string
+		input       Graph[string]
+		options     []BetweennessOption
+		want        map[string]float64
+		wantError   error
+	}{
+		{
+			description: "undirected star",
+			input:       edgeListGraph(false, [2]string{"c", "a"}, [2]string{"c", "b"}, [2]string{"c", "d"}),
+			want:        map[string]float64{"a": 0, "b": 0, "c": 1, "d": 0},
+		},
+		{
+			description: "directed path",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "c"}),
+			want:        map[string]float64{"a": 0, "b": 0.5, "c": 0},
+		},
+		{
+			description: "undirected square splits paths",
+			input:       edgeListGraph(false, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "d"}, [2]string{"d", "a"}),
+			options:     []BetweennessOption{BetweennessWorkers(2)},
+			want:        map[string]float64{"a": 1.0 / 6.0, "b": 1.0 / 6.0, "c": 1.0 / 6.0, "d": 1.0 / 6.0},
+		},
+		{
+			description: "weighted triangle",
+			input:       weighted,
+			want:        map[string]float64{"a": 0, "b": 1, "c": 0},
+		},
+		{
+			description: "negative samples",
+			input:       edgeListGraph(false, [2]string{"a", "b"}),
+			options:     []BetweennessOption{BetweennessSamples(-1, 0)},
+			wantError:   InvalidArgumentErr{"-1", "number of samples must be non-negative"},
+		},
+		{
+			description: "zero weight",
+			input:       zeroWeightSquare,
+			wantError:   InvalidArgumentErr{"0", "edge weights must be positive"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.BetweennessCentrality(test.options...)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBetweennessCentralitySamples(t *testing.T) {
+	g := barbellGraph()
+
+	exact, err := g.BetweennessCentrality()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Sampling every vertex is the exact computation.
+	all, err := g.BetweennessCentrality(BetweennessSamples(6, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(all, exact, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("%v != %v", all, exact)
+	}
+
+	// The same seed gives the same estimate.
+	first, err := g.BetweennessCentrality(BetweennessSamples(3, 42))
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := g.BetweennessCentrality(BetweennessSamples(3, 42), BetweennessWorkers(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cmp.Equal(first, second, cmpopts.EquateApprox(0, 1e-9)) {
+		t.Errorf("%v != %v", first, second)
+	}
+}
+
+func TestEdgeBetweenness(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        map[Edge[int]]float64
+	}{
+		{
+			description: "barbell bridge",
+			input:       barbellGraph(),
+			want: map[Edge[int]]float64{
+				{From: 1, To: 2}: 2.0 / 30.0,
+				{From: 2, To: 3}: 8.0 / 30.0,
+				{From: 1, To: 3}: 8.0 / 30.0,
+				{From: 4, To: 5}: 8.0 / 30.0,
+				{From: 5, To: 6}: 2.0 / 30.0,
+				{From: 4, To: 6}: 8.0 / 30.0,
+				{From: 3, To: 4}: 18.0 / 30.0,
+			},
+		},
+		{
+			description: "directed path",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			want: map[Edge[int]]float64{
+				{From: 1, To: 2}: 2.0 / 6.0,
+				{From: 2, To: 3}: 2.0 / 6.0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.EdgeBetweenness()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,233 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/subpop/go-adt"
+)
+
+// BidirectionalDijkstra computes a shortest path from source to target by
+// running Dijkstra's algorithm forward from source and backward from target
+// at the same time, alternating between the two. The backward search follows
+// inbound edges in a directed graph. The search stops as soon as a vertex has
+// been settled in both directions, so it usually explores far fewer vertices
+// than Dijkstra, which settles every vertex reachable from source. Like
+// Dijkstra, it only works correctly with non-negative edge weights. If target
+// is unreachable, the result has a Distance of math.Inf(1) and a nil Path. If
+// source or target do not exist in the graph, it returns MissingVertexErr.
+func (g *Graph[V]) BidirectionalDijkstra(source, target V) (PathResult[V], error) {
+	if _, ok := g.vertices[source]; !ok {
+		return PathResult[V]{}, &MissingVertexErr[V]{v: source}
+	}
+	if _, ok := g.vertices[target]; !ok {
+		return PathResult[V]{}, &MissingVertexErr[V]{v: target}
+	}
+	if source == target {
+		return PathResult[V]{Distance: 0, Path: []V{source}}, nil
+	}
+
+	forward := newDijkstraFrontier(source, g.outboundEdges)
+	backward := newDijkstraFrontier(target, g.inboundEdges)
+
+	// best is the length of the shortest path seen so far, which crosses
+	// from the forward to the backward search along the edge from meetFrom
+	// to meetTo.
+	best := math.Inf(1)
+	var meetFrom, meetTo V
+	for side := 0; ; side ^= 1 {
+		this, other := forward, backward
+		if side == 1 {
+			this, other = backward, forward
+		}
+
+		u, ok := this.settleNext()
+		if !ok || other.settled[u] {
+			break
+		}
+
+		for v, weight := range this.edges(u) {
+			alt := this.dist[u] + weight
+			if d, ok := this.dist[v]; !ok || alt < d {
+				this.dist[v] = alt
+				this.parent[v] = u
+				this.pq.Push(v, alt)
+			}
+			if d, ok := other.dist[v]; ok && alt+d < best {
+				best = alt + d
+				if side == 0 {
+					meetFrom, meetTo = u, v
+				} else {
+					meetFrom, meetTo = v, u
+				}
+			}
+		}
+	}
+
+	if math.IsInf(best, 1) {
+		return PathResult[V]{Distance: math.Inf(1), Path: nil}, nil
+	}
+
+	return PathResult[V]{
+		Distance: best,
+		Path:     joinPaths(forward.parent, backward.parent, source, target, meetFrom, meetTo),
+	}, nil
+}
+
+// BidirectionalBFS computes a path with the fewest edges from source to
+// target by breadth-first search from both ends, expanding one whole level of
+// the smaller frontier at a time until the two searches meet. The backward
+// search follows inbound edges in a directed graph. Edge weights are ignored,
+// and the Distance of the result is the number of edges on the path. If
+// target is unreachable, the result has a Distance of math.Inf(1) and a nil
+// Path. If source or target do not exist in the graph, it returns
+// MissingVertexErr.
+func (g *Graph[V]) BidirectionalBFS(source, target V) (PathResult[V], error) {
+	if _, ok := g.vertices[source]; !ok {
+		return PathResult[V]{}, &MissingVertexErr[V]{v: source}
+	}
+	if _, ok := g.vertices[target]; !ok {
+		return PathResult[V]{}, &MissingVertexErr[V]{v: target}
+	}
+	if source == target {
+		return PathResult[V]{Distance: 0, Path: []V{source}}, nil
+	}
+
+	forward := newBFSFrontier(source, g.outboundEdges)
+	backward := newBFSFrontier(target, g.inboundEdges)
+	for len(forward.level) > 0 && len(backward.level) > 0 {
+		this, other, isForward := forward, backward, true
+		if len(backward.level) < len(forward.level) {
+			this, other, isForward = backward, forward, false
+		}
+
+		// Finish the whole level before deciding, since a later vertex of
+		// the level may meet the other search closer to its start.
+		best := math.Inf(1)
+		var meetFrom, meetTo V
+		next := make([]V, 0)
+		for _, u := range this.level {
+			for v := range this.edges(u) {
+				if d, ok := other.dist[v]; ok && this.dist[u]+1+d < best {
+					best = this.dist[u] + 1 + d
+					if isForward {
+						meetFrom, meetTo = u, v
+					} else {
+						meetFrom, meetTo = v, u
+					}
+				}
+				if _, ok := this.dist[v]; !ok {
+					this.dist[v] = this.dist[u] + 1
+					this.parent[v] = u
+					next = append(next, v)
+				}
+			}
+		}
+
+		if !math.IsInf(best, 1) {
+			return PathResult[V]{
+				Distance: best,
+				Path:     joinPaths(forward.parent, backward.parent, source, target, meetFrom, meetTo),
+			}, nil
+		}
+		this.level = next
+	}
+
+	return PathResult[V]{Distance: math.Inf(1), Path: nil}, nil
+}
+
+// A dijkstraFrontier is the state of one direction of BidirectionalDijkstra.
+// The parent of a vertex is its predecessor on the forward search and its
+// successor on the backward search.
+type dijkstraFrontier[V comparable] struct {
+	dist    map[V]float64
+	parent  map[V]V
+	settled set[V]
+	pq      *adt.PriorityQueue[V]
+	edges   func(V) edgeMap[V]
+}
+
+func newDijkstraFrontier[V comparable](start V, edges func(V) edgeMap[V]) *dijkstraFrontier[V] {
+	f := &dijkstraFrontier[V]{
+		dist:    map[V]float64{start: 0},
+		parent:  make(map[V]V),
+		settled: make(set[V]),
+		pq:      adt.NewPriorityQueue[V](0),
+		edges:   edges,
+	}
+	f.pq.Push(start, 0)
+
+	return f
+}
+
+// settleNext pops the closest vertex that is not yet settled and settles it.
+// It returns false if no such vertex is left.
+func (f *dijkstraFrontier[V]) settleNext() (V, bool) {
+	for f.pq.Len() > 0 {
+		u := *f.pq.Pop()
+		if !f.settled[u] {
+			f.settled[u] = true
+			return u, true
+		}
+	}
+
+	var zero V
+	return zero, false
+}
+
+// A bfsFrontier is the state of one direction of BidirectionalBFS: the
+// vertices found so far with their distances and parents, as for
+// dijkstraFrontier, and the vertices of the level to expand next.
+type bfsFrontier[V comparable] struct {
+	dist   map[V]float64
+	parent map[V]V
+	level  []V
+	edges  func(V) edgeMap[V]
+}
+
+func newBFSFrontier[V comparable](start V, edges func(V) edgeMap[V]) *bfsFrontier[V] {
+	return &bfsFrontier[V]{
+		dist:   map[V]float64{start: 0},
+		parent: make(map[V]V),
+		level:  []V{start},
+		edges:  edges,
+	}
+}
+
+// outboundEdges returns the edges leaving v, mapped to their weights.
+func (g *Graph[V]) outboundEdges(v V) edgeMap[V] {
+	return g.adjacencyMap[v].Explicit
+}
+
+// inboundEdges returns the edges entering v, keyed by their tail and mapped
+// to their weights. In an undirected graph, these are the same as the
+// outbound edges.
+func (g *Graph[V]) inboundEdges(v V) edgeMap[V] {
+	if g.isDirected {
+		return g.adjacencyMap[v].Implicit
+	}
+	return g.adjacencyMap[v].Explicit
+}
+
+// joinPaths joins the path from source to from, found by following
+// predecessors in forward, with the path from to to target, found by following
+// successors in backward.
+func joinPaths[V comparable](forward, backward map[V]V, source, target, from, to V) []V {
+	path := make([]V, 0)
+	for v := from; ; v = forward[v] {
+		path = append(path, v)
+		if v == source {
+			break
+		}
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	for v := to; ; v = backward[v] {
+		path = append(path, v)
+		if v == target {
+			break
+		}
+	}
+
+	return path
+}
@@ -0,0 +1,213 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// randomWeightedGraph returns a graph on n vertices in which each ordered
+// pair of distinct vertices is joined with probability p, with integer
+// weights from 1 to 9.
+func randomWeightedGraph(r *rand.Rand, isDirected bool, n int, p float64) Graph[int] {
+	g := NewGraph[int](isDirected)
+	for v := 0; v < n; v++ {
+		_ = g.AddVertex(v)
+	}
+	for u := 0; u < n; u++ {
+		for v := 0; v < n; v++ {
+			if u != v && !g.HasEdge(u, v) && r.Float64() < p {
+				_ = g.AddEdge(u, v, float64(1+r.Intn(9)))
+			}
+		}
+	}
+	return g
+}
+
+// isPath returns true if path starts at source, ends at target and follows
+// edges of g.
+func isPath[V comparable](g Graph[V], path []V, source, target V) bool {
+	if len(path) == 0 || path[0] != source || path[len(path)-1] != target {
+		return false
+	}
+	for i := 1; i < len(path); i++ {
+		if !g.HasEdge(path[i-1], path[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestBidirectionalDijkstra(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[string]
+		source      string
+		target      string
+		want        PathResult[string]
+		wantError   error
+	}{
+		{
+			description: "directed",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "H",
+			want:        PathResult[string]{Distance: 5, Path: []string{"C", "E", "F", "H"}},
+		},
+		{
+			description: "source is target",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "C",
+			want:        PathResult[string]{Distance: 0, Path: []string{"C"}},
+		},
+		{
+			description: "unreachable",
+			input:       yenGraph(),
+			source:      "H",
+			target:      "C",
+			want:        PathResult[string]{Distance: math.Inf(1)},
+		},
+		{
+			description: "missing vertex",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "Z",
+			wantError:   &MissingVertexErr[string]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.BidirectionalDijkstra(test.source, test.target)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBidirectionalDijkstraRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		g := randomWeightedGraph(r, i%2 == 0, 12, 0.2)
+		source, target := r.Intn(12), r.Intn(12)
+
+		want, _ := g.Dijkstra(source)
+		got, err := g.BidirectionalDijkstra(source, target)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Distance != want[target].Distance {
+			t.Fatalf("%v: %v to %v: %v != %v", g, source, target, got.Distance, want[target].Distance)
+		}
+		if !math.IsInf(got.Distance, 1) {
+			if !isPath(g, got.Path, source, target) || g.pathWeight(got.Path) != got.Distance {
+				t.Errorf("%v: %v is not a path of weight %v from %v to %v", g, got.Path, got.Distance, source, target)
+			}
+		}
+	}
+}
+
+func TestBidirectionalBFS(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		source      int
+		target      int
+		want        PathResult[int]
+		wantError   error
+	}{
+		{
+			description: "directed path",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}),
+			source:      1,
+			target:      5,
+			want:        PathResult[int]{Distance: 4, Path: []int{1, 2, 3, 4, 5}},
+		},
+		{
+			description: "shortcut",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{1, 6}, [2]int{6, 5}),
+			source:      1,
+			target:      5,
+			want:        PathResult[int]{Distance: 2, Path: []int{1, 6, 5}},
+		},
+		{
+			description: "adjacent",
+			input:       edgeListGraph(false, [2]int{1, 2}),
+			source:      2,
+			target:      1,
+			want:        PathResult[int]{Distance: 1, Path: []int{2, 1}},
+		},
+		{
+			description: "against direction",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			source:      3,
+			target:      1,
+			want:        PathResult[int]{Distance: math.Inf(1)},
+		},
+		{
+			description: "missing vertex",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			source:      3,
+			target:      1,
+			wantError:   &MissingVertexErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.BidirectionalBFS(test.source, test.target)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestBidirectionalBFSRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		g := randomWeightedGraph(r, i%2 == 0, 12, 0.15)
+		source, target := r.Intn(12), r.Intn(12)
+
+		want, _ := g.BFSShortestPath(source)
+		got, err := g.BidirectionalBFS(source, target)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Distance != want[target].Distance {
+			t.Fatalf("%v: %v to %v: %v != %v", g, source, target, got.Distance, want[target].Distance)
+		}
+		if !math.IsInf(got.Distance, 1) {
+			if !isPath(g, got.Path, source, target) || float64(len(got.Path)-1) != got.Distance {
+				t.Errorf("%v: %v is not a path of length %v from %v to %v", g, got.Path, got.Distance, source, target)
+			}
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/subpop/go-adt"
+)
+
+// DegreeCentrality returns the degree of every vertex divided by n-1, the
+// largest degree possible in a simple graph with n vertices. In a directed
+// graph, d selects the in-degree (Inbound), the out-degree (Outbound) or their
+// sum (NoDirection); in an undirected graph d is ignored. In a graph with a
+// single vertex, that vertex has a centrality of 1.
+func (g *Graph[V]) DegreeCentrality(d Direction) map[V]float64 {
+	centrality := make(map[V]float64, len(g.vertices))
+	if len(g.vertices) == 1 {
+		for v := range g.vertices {
+			centrality[v] = 1
+		}
+		return centrality
+	}
+
+	scale := 1 / float64(len(g.vertices)-1)
+	for v := range g.vertices {
+		var degree int
+		if g.isDirected {
+			in, _ := g.InDegree(v)
+			out, _ := g.OutDegree(v)
+			switch d {
+			case Inbound:
+				degree = in
+			case Outbound:
+				degree = out
+			default:
+				degree = in + out
+			}
+		} else {
+			degree, _ = g.Degree(v)
+		}
+		centrality[v] = float64(degree) * scale
+	}
+
+	return centrality
+}
+
+// ClosenessCentrality returns, for every vertex v, the reciprocal of the
+// average shortest-path distance from v to the vertices it can reach. To keep
+// scores comparable in disconnected graphs, the result is scaled by the
+// fraction of other vertices v can reach (Wasserman and Faust), so a vertex
+// that reaches r-1 other vertices with total distance s scores
+// (r-1)/(n-1) * (r-1)/s. A vertex that reaches no other vertex scores 0. In a
+// directed graph, distances follow outbound edges. Distances are sums of edge
+// weights, found by breadth-first search if every edge has weight 1 and by
+// Dijkstra otherwise.
+func (g *Graph[V]) ClosenessCentrality() map[V]float64 {
+	centrality := make(map[V]float64, len(g.vertices))
+	weighted := !g.hasUnitWeights()
+	for v := range g.vertices {
+		total, reachable := 0.0, 0
+		for u, distance := range g.shortestDistances(v, weighted) {
+			if u == v || math.IsInf(distance, 1) {
+				continue
+			}
+			total += distance
+			reachable++
+		}
+		if reachable == 0 || total == 0 {
+			centrality[v] = 0
+			continue
+		}
+		r := float64(reachable)
+		centrality[v] = (r / float64(len(g.vertices)-1)) * (r / total)
+	}
+
+	return centrality
+}
+
+// HarmonicCentrality returns, for every vertex v, the sum of the reciprocal
+// shortest-path distances from v to every other vertex, divided by n-1.
+// Unreachable vertices contribute 0, so the measure is well defined on
+// disconnected graphs. In a directed graph, distances follow outbound edges.
+// Distances are sums of edge weights, found as by ClosenessCentrality.
+func (g *Graph[V]) HarmonicCentrality() map[V]float64 {
+	centrality := make(map[V]float64, len(g.vertices))
+	weighted := !g.hasUnitWeights()
+	for v := range g.vertices {
+		total := 0.0
+		for u, distance := range g.shortestDistances(v, weighted) {
+			if u == v || distance == 0 || math.IsInf(distance, 1) {
+				continue
+			}
+			total += 1 / distance
+		}
+		if len(g.vertices) > 1 {
+			total /= float64(len(g.vertices) - 1)
+		}
+		centrality[v] = total
+	}
+
+	return centrality
+}
+
+// isWeighted returns true if the edges of the graph do not all have the same
+// weight. Graphs whose edges share a single weight may be treated as
+// unweighted only where scaling every distance by the same factor does not
+// change the result, as in BetweennessCentrality.
+func (g *Graph[V]) isWeighted() bool {
+	first := true
+	var weight float64
+	for _, edges := range g.adjacencyMap {
+		for _, w := range edges.Explicit {
+			if first {
+				weight, first = w, false
+			} else if w != weight {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// hasUnitWeights returns true if every edge of the graph has weight 1, so
+// that hop counts are the same as weighted distances.
+func (g *Graph[V]) hasUnitWeights() bool {
+	for _, edges := range g.adjacencyMap {
+		for _, w := range edges.Explicit {
+			if w != 1 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// shortestDistances returns the distance from source to every vertex,
+// measured by weight if weighted is true and in hops otherwise. Unreachable
+// vertices have a distance of math.Inf(1). Only distances are kept, so no
+// paths are built.
+func (g *Graph[V]) shortestDistances(source V, weighted bool) map[V]float64 {
+	distances := make(map[V]float64, len(g.vertices))
+	if weighted {
+		tree := g.dijkstraSearch(source, nil, nil, nil)
+		for v := range g.vertices {
+			distances[v] = tree.Distance(v)
+		}
+		return distances
+	}
+
+	for v := range g.vertices {
+		distances[v] = math.Inf(1)
+	}
+	distances[source] = 0
+	queue := adt.NewQueue[V]()
+	_ = queue.Enqueue(source)
+	for queue.Len() > 0 {
+		u := *queue.Dequeue()
+		for v := range g.adjacencyMap[u].Explicit {
+			if math.IsInf(distances[v], 1) {
+				distances[v] = distances[u] + 1
+				_ = queue.Enqueue(v)
+			}
+		}
+	}
+
+	return distances
+}
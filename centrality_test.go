@@ -0,0 +1,157 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestDegreeCentrality(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		direction   Direction
+		want        map[int]float64
+	}{
+		{
+			description: "undirected path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        map[int]float64{1: 0.5, 2: 1, 3: 0.5},
+		},
+		{
+			description: "directed path inbound",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			direction:   Inbound,
+			want:        map[int]float64{1: 0, 2: 0.5, 3: 0.5},
+		},
+		{
+			description: "directed path outbound",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			direction:   Outbound,
+			want:        map[int]float64{1: 0.5, 2: 0.5, 3: 0},
+		},
+		{
+			description: "directed path both directions",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			direction:   NoDirection,
+			want:        map[int]float64{1: 0.5, 2: 1, 3: 0.5},
+		},
+		{
+			description: "single vertex",
+			input: Graph[int]{
+				vertices: set[int]{1: true},
+				adjacencyMap: adjacencyMap[int]{
+					1: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{}, Implicit: edgeMap[int]{}},
+				},
+			},
+			want: map[int]float64{1: 1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := test.input.DegreeCentrality(test.direction)
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestClosenessCentrality(t *testing.T) {
+	weighted := NewGraph[int](false)
+	_ = weighted.AddEdge(1, 2, 1)
+	_ = weighted.AddEdge(2, 3, 3)
+
+	uniform := NewGraph[int](false)
+	_ = uniform.AddEdge(1, 2, 5)
+	_ = uniform.AddEdge(2, 3, 5)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        map[int]float64
+	}{
+		{
+			description: "undirected path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        map[int]float64{1: 2.0 / 3.0, 2: 1, 3: 2.0 / 3.0},
+		},
+		{
+			description: "directed path",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			want:        map[int]float64{1: 2.0 / 3.0, 2: 0.5, 3: 0},
+		},
+		{
+			description: "weighted path",
+			input:       weighted,
+			want:        map[int]float64{1: 0.4, 2: 0.5, 3: 2.0 / 7.0},
+		},
+		{
+			description: "uniform weights",
+			input:       uniform,
+			want:        map[int]float64{1: 2.0 / 15.0, 2: 0.2, 3: 2.0 / 15.0},
+		},
+		{
+			description: "disconnected",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{3, 4}),
+			want:        map[int]float64{1: 1.0 / 3.0, 2: 1.0 / 3.0, 3: 1.0 / 3.0, 4: 1.0 / 3.0},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := test.input.ClosenessCentrality()
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestHarmonicCentrality(t *testing.T) {
+	weighted := NewGraph[int](false)
+	_ = weighted.AddEdge(1, 2, 1)
+	_ = weighted.AddEdge(2, 3, 3)
+
+	uniform := NewGraph[int](false)
+	_ = uniform.AddEdge(1, 2, 5)
+	_ = uniform.AddEdge(2, 3, 5)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        map[int]float64
+	}{
+		{
+			description: "undirected path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        map[int]float64{1: 0.75, 2: 1, 3: 0.75},
+		},
+		{
+			description: "directed path",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			want:        map[int]float64{1: 0.75, 2: 0.5, 3: 0},
+		},
+		{
+			description: "weighted path",
+			input:       weighted,
+			want:        map[int]float64{1: 0.625, 2: 2.0 / 3.0, 3: (1.0/3.0 + 0.25) / 2},
+		},
+		{
+			description: "uniform weights",
+			input:       uniform,
+			want:        map[int]float64{1: 0.15, 2: 0.2, 3: 0.15},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := test.input.HarmonicCentrality()
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+}
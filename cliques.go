@@ -0,0 +1,121 @@
+package graph
+
+// MaximalCliques returns an iterator over the maximal cliques of an undirected
+// graph. A clique is a set of pairwise adjacent vertices; it is maximal if no
+// other vertex can be added to it. The cliques are enumerated lazily using the
+// Bron-Kerbosch algorithm with pivoting, with the outer level visiting vertices
+// in degeneracy order. Enumeration stops as soon as yield returns false.
+// Self-loops are ignored. If the graph is directed, it returns
+// DirectedGraphErr.
+func (g *Graph[V]) MaximalCliques() (func(yield func([]V) bool), error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	return func(yield func([]V) bool) {
+		order, _ := g.coreDecomposition()
+		position := make(map[V]int, len(order))
+		for i, v := range order {
+			position[v] = i
+		}
+
+		for i, v := range order {
+			p := make(set[V])
+			x := make(set[V])
+			for n := range g.adjacencyMap[v].Explicit {
+				if n == v {
+					continue
+				}
+				if position[n] > i {
+					p[n] = true
+				} else {
+					x[n] = true
+				}
+			}
+			if !g.bronKerbosch([]V{v}, p, x, yield) {
+				return
+			}
+		}
+	}, nil
+}
+
+// MaximumClique returns a largest clique of an undirected graph. If the graph
+// is directed, it returns DirectedGraphErr.
+func (g *Graph[V]) MaximumClique() ([]V, error) {
+	cliques, err := g.MaximalCliques()
+	if err != nil {
+		return nil, err
+	}
+
+	var maximum []V
+	cliques(func(clique []V) bool {
+		if len(clique) > len(maximum) {
+			maximum = clique
+		}
+		return true
+	})
+
+	return maximum, nil
+}
+
+// bronKerbosch reports every maximal clique that extends r with vertices from
+// p and none from x. It returns false if yield asked to stop.
+func (g *Graph[V]) bronKerbosch(r []V, p, x set[V], yield func([]V) bool) bool {
+	if len(p) == 0 {
+		if len(x) == 0 {
+			clique := make([]V, len(r))
+			copy(clique, r)
+			return yield(clique)
+		}
+		return true
+	}
+
+	// Choose the pivot from p ∪ x with the most neighbors in p; its neighbors
+	// need not be branched on, since any maximal clique containing one of them
+	// either contains the pivot or a non-neighbor of it.
+	var pivot V
+	best := -1
+	for _, candidates := range []set[V]{p, x} {
+		for u := range candidates {
+			count := 0
+			for n := range g.adjacencyMap[u].Explicit {
+				if p[n] && n != u {
+					count++
+				}
+			}
+			if count > best {
+				pivot, best = u, count
+			}
+		}
+	}
+
+	branches := make([]V, 0, len(p))
+	for v := range p {
+		if _, ok := g.adjacencyMap[pivot].Explicit[v]; !ok || v == pivot {
+			branches = append(branches, v)
+		}
+	}
+
+	for _, v := range branches {
+		np := make(set[V])
+		nx := make(set[V])
+		for n := range g.adjacencyMap[v].Explicit {
+			if n == v {
+				continue
+			}
+			if p[n] {
+				np[n] = true
+			}
+			if x[n] {
+				nx[n] = true
+			}
+		}
+		if !g.bronKerbosch(append(r, v), np, nx, yield) {
+			return false
+		}
+		delete(p, v)
+		x[v] = true
+	}
+
+	return true
+}
@@ -0,0 +1,142 @@
+package graph
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestMaximalCliques(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        [][]int
+		wantError   error
+	}{
+		{
+			description: "two triangles sharing an edge",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{2, 4}, [2]int{3, 4}),
+			want:        [][]int{{1, 2, 3}, {2, 3, 4}},
+		},
+		{
+			description: "K4 with a pendant path",
+			input: edgeListGraph(false,
+				[2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4},
+				[2]int{4, 5}, [2]int{5, 6},
+			),
+			want: [][]int{{1, 2, 3, 4}, {4, 5}, {5, 6}},
+		},
+		{
+			description: "isolated vertex and self-loop",
+			input: Graph[int]{
+				vertices: set[int]{1: true, 2: true},
+				adjacencyMap: adjacencyMap[int]{
+					1: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{1: 1}, Implicit: edgeMap[int]{}},
+					2: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{}, Implicit: edgeMap[int]{}},
+				},
+			},
+			want: [][]int{{1}, {2}},
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			cliques, err := test.input.MaximalCliques()
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := make([][]int, 0)
+				cliques(func(clique []int) bool {
+					sort.Ints(clique)
+					got = append(got, clique)
+					return true
+				})
+				if !cmp.Equal(got, test.want, cmpopts.SortSlices(func(x, y []int) bool {
+					return x[0] < y[0]
+				})) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestMaximalCliquesStop(t *testing.T) {
+	g := edgeListGraph(false, [2]int{1, 2}, [2]int{3, 4}, [2]int{5, 6})
+
+	cliques, err := g.MaximalCliques()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count := 0
+	cliques(func(clique []int) bool {
+		count++
+		return false
+	})
+	if count != 1 {
+		t.Errorf("%v != %v", count, 1)
+	}
+}
+
+func TestMaximumClique(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        []int
+		wantError   error
+	}{
+		{
+			description: "K4 with a pendant path",
+			input: edgeListGraph(false,
+				[2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4},
+				[2]int{4, 5}, [2]int{5, 6},
+			),
+			want: []int{1, 2, 3, 4},
+		},
+		{
+			description: "empty graph",
+			input:       NewGraph[int](false),
+			want:        nil,
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.MaximumClique()
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.SortSlices(func(x, y int) bool {
+					return x < y
+				})) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package graph
+
+// Triangles returns the number of triangles that include vertex v. In an
+// undirected graph this is the number of edges between neighbors of v. In a
+// directed graph, each of the eight possible directed triangles on three
+// vertices is counted separately, following Fagiolo (2007), so a triple with
+// all six arcs present counts as eight. Self-loops are ignored. If the graph
+// does not contain vertex v, it returns MissingVertexErr.
+func (g *Graph[V]) Triangles(v V) (int, error) {
+	if _, ok := g.vertices[v]; !ok {
+		return 0, &MissingVertexErr[V]{v}
+	}
+
+	return g.triangles(v), nil
+}
+
+// TotalTriangles returns the number of triangles in the graph, counted as in
+// Triangles.
+func (g *Graph[V]) TotalTriangles() int {
+	total := 0
+	for v := range g.vertices {
+		total += g.triangles(v)
+	}
+
+	// Every triangle is counted once at each of its three vertices.
+	return total / 3
+}
+
+// LocalClusteringCoefficient returns the fraction of possible triangles
+// through vertex v that exist. In an undirected graph this is the number of
+// edges between neighbors of v divided by d(d-1)/2, where d is the degree of
+// v. In a directed graph the denominator is d(d-1) - 2r, where d is the total
+// degree of v and r the number of its reciprocated arcs. A vertex with fewer
+// than two neighbors has a coefficient of 0. If the graph does not contain
+// vertex v, it returns MissingVertexErr.
+func (g *Graph[V]) LocalClusteringCoefficient(v V) (float64, error) {
+	if _, ok := g.vertices[v]; !ok {
+		return 0, &MissingVertexErr[V]{v}
+	}
+
+	possible := g.possibleTriangles(v)
+	if possible == 0 {
+		return 0, nil
+	}
+
+	return float64(g.triangles(v)) / float64(possible), nil
+}
+
+// AverageClustering returns the mean of LocalClusteringCoefficient over all
+// vertices of the graph. An empty graph has an average clustering of 0.
+func (g *Graph[V]) AverageClustering() float64 {
+	if len(g.vertices) == 0 {
+		return 0
+	}
+
+	total := 0.0
+	for v := range g.vertices {
+		if possible := g.possibleTriangles(v); possible > 0 {
+			total += float64(g.triangles(v)) / float64(possible)
+		}
+	}
+
+	return total / float64(len(g.vertices))
+}
+
+// Transitivity returns the global clustering coefficient of the graph: the
+// number of triangles at all vertices divided by the number of possible
+// triangles at all vertices. In an undirected graph this is three times the
+// number of triangles divided by the number of connected triples. A graph
+// without connected triples has a transitivity of 0.
+func (g *Graph[V]) Transitivity() float64 {
+	triangles, possible := 0, 0
+	for v := range g.vertices {
+		triangles += g.triangles(v)
+		possible += g.possibleTriangles(v)
+	}
+
+	if possible == 0 {
+		return 0
+	}
+
+	return float64(triangles) / float64(possible)
+}
+
+// triangles computes ((A+Aᵀ)³)ᵥᵥ/2, where A is the adjacency matrix without
+// self-loops. In an undirected graph A is symmetric and already counts both
+// directions, so A is used in place of A+Aᵀ.
+func (g *Graph[V]) triangles(v V) int {
+	neighbors := g.arcCounts(v)
+
+	sum := 0
+	for u, vu := range neighbors {
+		for w, wv := range neighbors {
+			if u == w {
+				continue
+			}
+			if uw := g.arcs(u, w); uw > 0 {
+				sum += vu * uw * wv
+			}
+		}
+	}
+
+	return sum / 2
+}
+
+// possibleTriangles returns the largest value triangles(v) can take given the
+// neighbors of v.
+func (g *Graph[V]) possibleTriangles(v V) int {
+	neighbors := g.arcCounts(v)
+
+	if !g.isDirected {
+		d := len(neighbors)
+		return d * (d - 1) / 2
+	}
+
+	total, reciprocal := 0, 0
+	for _, count := range neighbors {
+		total += count
+		if count == 2 {
+			reciprocal++
+		}
+	}
+
+	return total*(total-1) - 2*reciprocal
+}
+
+// arcCounts maps each neighbor of v, other than v itself, to the number of
+// arcs between v and that neighbor: 1 in an undirected graph, and 1 or 2 in a
+// directed graph depending on whether the arc is reciprocated.
+func (g *Graph[V]) arcCounts(v V) map[V]int {
+	counts := make(map[V]int, len(g.adjacencyMap[v].Explicit))
+	for n := range g.adjacencyMap[v].Explicit {
+		if n != v {
+			counts[n]++
+		}
+	}
+	if g.isDirected {
+		for n := range g.adjacencyMap[v].Implicit {
+			if n != v {
+				counts[n]++
+			}
+		}
+	}
+
+	return counts
+}
+
+// arcs returns the number of arcs between u and w, in the same sense as
+// arcCounts.
+func (g *Graph[V]) arcs(u, w V) int {
+	count := 0
+	if _, ok := g.adjacencyMap[u].Explicit[w]; ok {
+		count++
+	}
+	if g.isDirected {
+		if _, ok := g.adjacencyMap[u].Implicit[w]; ok {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,244 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// pawGraph is a triangle (1, 2, 3) with a pendant vertex 4 attached to 3.
+func pawGraph() Graph[int] {
+	return edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{3, 4})
+}
+
+func TestTriangles(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		vertex      int
+		want        int
+		wantError   error
+	}{
+		{
+			description: "triangle vertex of paw",
+			input:       pawGraph(),
+			vertex:      3,
+			want:        1,
+		},
+		{
+			description: "pendant vertex of paw",
+			input:       pawGraph(),
+			vertex:      4,
+			want:        0,
+		},
+		{
+			description: "K4",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4}),
+			vertex:      1,
+			want:        3,
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			vertex:      1,
+			want:        1,
+		},
+		{
+			description: "directed complete triple",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 1}, [2]int{2, 3}, [2]int{3, 2}, [2]int{3, 1}, [2]int{1, 3}),
+			vertex:      1,
+			want:        8,
+		},
+		{
+			description: "missing vertex",
+			input:       pawGraph(),
+			vertex:      5,
+			wantError:   &MissingVertexErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Triangles(test.vertex)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != test.want {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestTotalTriangles(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        int
+	}{
+		{
+			description: "paw",
+			input:       pawGraph(),
+			want:        1,
+		},
+		{
+			description: "K4",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4}),
+			want:        4,
+		},
+		{
+			description: "self-loops ignored",
+			input:       edgeListGraph(false, [2]int{1, 1}, [2]int{1, 2}, [2]int{2, 2}),
+			want:        0,
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := test.input.TotalTriangles()
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestLocalClusteringCoefficient(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		vertex      int
+		want        float64
+		wantError   error
+	}{
+		{
+			description: "triangle vertex of paw",
+			input:       pawGraph(),
+			vertex:      1,
+			want:        1,
+		},
+		{
+			description: "hub of paw",
+			input:       pawGraph(),
+			vertex:      3,
+			want:        1.0 / 3.0,
+		},
+		{
+			description: "pendant vertex of paw",
+			input:       pawGraph(),
+			vertex:      4,
+			want:        0,
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			vertex:      1,
+			want:        0.5,
+		},
+		{
+			description: "directed complete triple",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 1}, [2]int{2, 3}, [2]int{3, 2}, [2]int{3, 1}, [2]int{1, 3}),
+			vertex:      1,
+			want:        1,
+		},
+		{
+			description: "missing vertex",
+			input:       pawGraph(),
+			vertex:      5,
+			wantError:   &MissingVertexErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.LocalClusteringCoefficient(test.vertex)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAverageClustering(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        float64
+	}{
+		{
+			description: "paw",
+			input:       pawGraph(),
+			want:        7.0 / 12.0,
+		},
+		{
+			description: "empty graph",
+			input:       NewGraph[int](false),
+			want:        0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := test.input.AverageClustering()
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestTransitivity(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        float64
+	}{
+		{
+			description: "paw",
+			input:       pawGraph(),
+			want:        0.6,
+		},
+		{
+			description: "star",
+			input:       edgeListGraph(false, [2]int{0, 1}, [2]int{0, 2}, [2]int{0, 3}),
+			want:        0,
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        0.5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := test.input.Transitivity()
+			if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,383 @@
+package graph
+
+import (
+	"context"
+	"fmt"
+	"sort"
+)
+
+// ColoringStrategy selects the order in which Coloring assigns colors to
+// vertices.
+type ColoringStrategy int
+
+const (
+	// GreedyStrategy colors vertices in arbitrary order, giving each vertex the
+	// smallest color not already used by one of its neighbors.
+	GreedyStrategy ColoringStrategy = iota
+
+	// WelshPowellStrategy colors vertices greedily in order of decreasing
+	// degree.
+	WelshPowellStrategy
+
+	// DSATURStrategy colors the vertex with the highest saturation (the number
+	// of distinct colors among its neighbors) next, breaking ties by the number
+	// of uncolored neighbors.
+	DSATURStrategy
+)
+
+// Coloring computes a proper vertex coloring of an undirected graph using the
+// given strategy. Colors are numbered from 0, and no two adjacent vertices are
+// assigned the same color. Self-loops are ignored. If the graph is directed, it
+// returns DirectedGraphErr.
+func (g *Graph[V]) Coloring(strategy ColoringStrategy) (map[V]int, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	switch strategy {
+	case GreedyStrategy:
+		return g.greedyColoring(g.GetAllVertices()), nil
+	case WelshPowellStrategy:
+		order := g.GetAllVertices()
+		sort.SliceStable(order, func(i, j int) bool {
+			return len(g.adjacencyMap[order[i]].Explicit) > len(g.adjacencyMap[order[j]].Explicit)
+		})
+		return g.greedyColoring(order), nil
+	case DSATURStrategy:
+		return g.dsaturColoring(), nil
+	default:
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", strategy), "unknown coloring strategy"}
+	}
+}
+
+// GreedyColoring computes a proper vertex coloring of an undirected graph by
+// visiting vertices in the given order and assigning each the smallest color
+// not used by its neighbors. Vertices missing from order are colored after it,
+// in arbitrary order. If the graph is directed, it returns DirectedGraphErr. If
+// order contains a vertex that is not in the graph, it returns
+// MissingVertexErr.
+func (g *Graph[V]) GreedyColoring(order []V) (map[V]int, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	seen := make(set[V])
+	full := make([]V, 0, len(g.vertices))
+	for _, v := range order {
+		if _, ok := g.vertices[v]; !ok {
+			return nil, &MissingVertexErr[V]{v}
+		}
+		if seen[v] {
+			return nil, InvalidArgumentErr{fmt.Sprintf("%v", v), "vertex appears more than once in order"}
+		}
+		seen[v] = true
+		full = append(full, v)
+	}
+	for v := range g.vertices {
+		if !seen[v] {
+			full = append(full, v)
+		}
+	}
+
+	return g.greedyColoring(full), nil
+}
+
+// greedyColoring assigns each vertex in order the smallest color not used by
+// any of its already colored neighbors.
+func (g *Graph[V]) greedyColoring(order []V) map[V]int {
+	colors := make(map[V]int, len(order))
+	for _, v := range order {
+		colors[v] = g.smallestFreeColor(v, colors)
+	}
+
+	return colors
+}
+
+// smallestFreeColor returns the smallest color that is not assigned to any
+// neighbor of v in colors.
+func (g *Graph[V]) smallestFreeColor(v V, colors map[V]int) int {
+	used := make([]bool, len(g.adjacencyMap[v].Explicit)+1)
+	for n := range g.adjacencyMap[v].Explicit {
+		if n == v {
+			continue
+		}
+		if c, ok := colors[n]; ok && c < len(used) {
+			used[c] = true
+		}
+	}
+
+	c := 0
+	for used[c] {
+		c++
+	}
+	return c
+}
+
+// dsaturColoring implements Brélaz's DSATUR heuristic.
+func (g *Graph[V]) dsaturColoring() map[V]int {
+	colors := make(map[V]int, len(g.vertices))
+	saturation := make(map[V]map[int]bool, len(g.vertices))
+	uncoloredDegree := make(map[V]int, len(g.vertices))
+	for v := range g.vertices {
+		saturation[v] = make(map[int]bool)
+		for n := range g.adjacencyMap[v].Explicit {
+			if n != v {
+				uncoloredDegree[v]++
+			}
+		}
+	}
+
+	for len(colors) < len(g.vertices) {
+		// Select the uncolored vertex with the highest saturation, breaking
+		// ties by the number of uncolored neighbors.
+		var next V
+		found := false
+		for v := range g.vertices {
+			if _, ok := colors[v]; ok {
+				continue
+			}
+			if !found ||
+				len(saturation[v]) > len(saturation[next]) ||
+				(len(saturation[v]) == len(saturation[next]) && uncoloredDegree[v] > uncoloredDegree[next]) {
+				next = v
+				found = true
+			}
+		}
+
+		c := g.smallestFreeColor(next, colors)
+		colors[next] = c
+		for n := range g.adjacencyMap[next].Explicit {
+			if n == next {
+				continue
+			}
+			saturation[n][c] = true
+			uncoloredDegree[n]--
+		}
+	}
+
+	return colors
+}
+
+// IsKColorable reports whether the vertices of an undirected graph can be
+// colored with at most k colors so that no two adjacent vertices share a
+// color. If they can, the returned map holds one such coloring. The search is
+// an exact backtracking search and may take exponential time; it checks ctx
+// periodically and returns ctx.Err() if ctx is done. If the graph is directed,
+// it returns DirectedGraphErr. If k is negative, it returns
+// InvalidArgumentErr.
+func (g *Graph[V]) IsKColorable(ctx context.Context, k int) (bool, map[V]int, error) {
+	if g.isDirected {
+		return false, nil, DirectedGraphErr{}
+	}
+
+	if k < 0 {
+		return false, nil, InvalidArgumentErr{fmt.Sprintf("%v", k), "k must be non-negative"}
+	}
+
+	return newColoringSearch(ctx, g).run(k)
+}
+
+// ChromaticNumber computes the smallest number of colors needed to properly
+// color an undirected graph, along with a coloring that uses that many colors.
+// A DSATUR coloring provides the upper bound and a greedily grown clique the
+// lower bound; the colorings in between are settled by the same backtracking
+// search as IsKColorable. It checks ctx periodically and returns ctx.Err() if
+// ctx is done. If the graph is directed, it returns DirectedGraphErr.
+func (g *Graph[V]) ChromaticNumber(ctx context.Context) (int, map[V]int, error) {
+	if g.isDirected {
+		return 0, nil, DirectedGraphErr{}
+	}
+
+	if len(g.vertices) == 0 {
+		return 0, map[V]int{}, nil
+	}
+
+	best := g.dsaturColoring()
+	upper := 0
+	for _, c := range best {
+		if c+1 > upper {
+			upper = c + 1
+		}
+	}
+
+	search := newColoringSearch(ctx, g)
+	for k := len(g.greedyClique()); k < upper; k++ {
+		ok, colors, err := search.run(k)
+		if err != nil {
+			return 0, nil, err
+		}
+		if ok {
+			return k, colors, nil
+		}
+	}
+
+	return upper, best, nil
+}
+
+// greedyClique grows a clique from every vertex by repeatedly adding the
+// highest-degree neighbor that is adjacent to all vertices chosen so far, and
+// returns the largest clique found.
+func (g *Graph[V]) greedyClique() []V {
+	var best []V
+	for v := range g.vertices {
+		candidates := make([]V, 0, len(g.adjacencyMap[v].Explicit))
+		for n := range g.adjacencyMap[v].Explicit {
+			if n != v {
+				candidates = append(candidates, n)
+			}
+		}
+		if len(candidates)+1 <= len(best) {
+			continue
+		}
+		sort.Slice(candidates, func(i, j int) bool {
+			return len(g.adjacencyMap[candidates[i]].Explicit) > len(g.adjacencyMap[candidates[j]].Explicit)
+		})
+
+		clique := []V{v}
+		for _, c := range candidates {
+			adjacent := true
+			for _, u := range clique {
+				if _, ok := g.adjacencyMap[c].Explicit[u]; !ok {
+					adjacent = false
+					break
+				}
+			}
+			if adjacent {
+				clique = append(clique, c)
+			}
+		}
+		if len(clique) > len(best) {
+			best = clique
+		}
+	}
+
+	return best
+}
+
+// coloringSearch holds the state of an exact backtracking search for a
+// k-coloring. Vertices are indexed to keep the inner loops on slices.
+type coloringSearch[V comparable] struct {
+	ctx      context.Context
+	vertices []V
+	adj      [][]int
+	colors   []int
+	steps    int
+}
+
+func newColoringSearch[V comparable](ctx context.Context, g *Graph[V]) *coloringSearch[V] {
+	s := &coloringSearch[V]{
+		ctx:      ctx,
+		vertices: g.GetAllVertices(),
+	}
+
+	index := make(map[V]int, len(s.vertices))
+	for i, v := range s.vertices {
+		index[v] = i
+	}
+
+	s.adj = make([][]int, len(s.vertices))
+	for i, v := range s.vertices {
+		for n := range g.adjacencyMap[v].Explicit {
+			if n != v {
+				s.adj[i] = append(s.adj[i], index[n])
+			}
+		}
+	}
+
+	return s
+}
+
+// run searches for a coloring with at most k colors.
+func (s *coloringSearch[V]) run(k int) (bool, map[V]int, error) {
+	if err := s.ctx.Err(); err != nil {
+		return false, nil, err
+	}
+	if len(s.vertices) == 0 {
+		return true, map[V]int{}, nil
+	}
+	if k == 0 {
+		return false, nil, nil
+	}
+
+	s.colors = make([]int, len(s.vertices))
+	for i := range s.colors {
+		s.colors[i] = -1
+	}
+
+	ok, err := s.assign(k, len(s.vertices), 0)
+	if err != nil || !ok {
+		return false, nil, err
+	}
+
+	colors := make(map[V]int, len(s.vertices))
+	for i, v := range s.vertices {
+		colors[v] = s.colors[i]
+	}
+	return true, colors, nil
+}
+
+// assign colors the remaining uncolored vertices, picking the most saturated
+// vertex first. Only colors up to one past the highest color in use are tried,
+// since any larger color would produce a symmetric assignment.
+func (s *coloringSearch[V]) assign(k, uncolored, used int) (bool, error) {
+	if uncolored == 0 {
+		return true, nil
+	}
+
+	s.steps++
+	if s.steps%1024 == 0 {
+		if err := s.ctx.Err(); err != nil {
+			return false, err
+		}
+	}
+
+	next, bestSaturation, bestDegree := -1, -1, -1
+	var forbidden []bool
+	for i := range s.vertices {
+		if s.colors[i] >= 0 {
+			continue
+		}
+		seen := make([]bool, k)
+		saturation, degree := 0, 0
+		for _, n := range s.adj[i] {
+			if c := s.colors[n]; c >= 0 {
+				if !seen[c] {
+					seen[c] = true
+					saturation++
+				}
+			} else {
+				degree++
+			}
+		}
+		if saturation == k {
+			// This vertex cannot be colored; fail early.
+			return false, nil
+		}
+		if saturation > bestSaturation || (saturation == bestSaturation && degree > bestDegree) {
+			next, bestSaturation, bestDegree = i, saturation, degree
+			forbidden = seen
+		}
+	}
+
+	limit := used + 1
+	if limit > k {
+		limit = k
+	}
+	for c := 0; c < limit; c++ {
+		if forbidden[c] {
+			continue
+		}
+		s.colors[next] = c
+		nextUsed := used
+		if c == used {
+			nextUsed++
+		}
+		ok, err := s.assign(k, uncolored-1, nextUsed)
+		if err != nil || ok {
+			return ok, err
+		}
+	}
+	s.colors[next] = -1
+
+	return false, nil
+}
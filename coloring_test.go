@@ -0,0 +1,365 @@
+package graph
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// edgeListGraph builds a graph from a list of edges, each with weight 1.
+func edgeListGraph[V comparable](isDirected bool, edges ...[2]V) Graph[V] {
+	g := NewGraph[V](isDirected)
+	for _, e := range edges {
+		_ = g.AddEdge(e[0], e[1], 1)
+	}
+	return g
+}
+
+// isProperColoring returns true if no edge of g joins two vertices of the same
+// color and every vertex is colored.
+func isProperColoring[V comparable](g Graph[V], colors map[V]int) bool {
+	if len(colors) != g.NumVertex() {
+		return false
+	}
+	for u := range g.vertices {
+		for v := range g.adjacencyMap[u].Explicit {
+			if u != v && colors[u] == colors[v] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func numColors(colors map[int]int) int {
+	distinct := make(map[int]bool)
+	for _, c := range colors {
+		distinct[c] = true
+	}
+	return len(distinct)
+}
+
+func TestColoring(t *testing.T) {
+	petersen := edgeListGraph(false,
+		[2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 0},
+		[2]int{0, 5}, [2]int{1, 6}, [2]int{2, 7}, [2]int{3, 8}, [2]int{4, 9},
+		[2]int{5, 7}, [2]int{7, 9}, [2]int{9, 6}, [2]int{6, 8}, [2]int{8, 5},
+	)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		strategy    ColoringStrategy
+		wantMax     int
+		wantError   error
+	}{
+		{
+			description: "triangle greedy",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			strategy:    GreedyStrategy,
+			wantMax:     3,
+		},
+		{
+			description: "even cycle DSATUR",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{5, 6}, [2]int{6, 1}),
+			strategy:    DSATURStrategy,
+			wantMax:     2,
+		},
+		{
+			description: "star Welsh-Powell",
+			input:       edgeListGraph(false, [2]int{0, 1}, [2]int{0, 2}, [2]int{0, 3}, [2]int{0, 4}),
+			strategy:    WelshPowellStrategy,
+			wantMax:     2,
+		},
+		{
+			description: "petersen DSATUR",
+			input:       petersen,
+			strategy:    DSATURStrategy,
+			wantMax:     3,
+		},
+		{
+			description: "self-loop ignored",
+			input:       edgeListGraph(false, [2]int{1, 1}, [2]int{1, 2}),
+			strategy:    GreedyStrategy,
+			wantMax:     2,
+		},
+		{
+			description: "empty graph",
+			input:       NewGraph[int](false),
+			strategy:    DSATURStrategy,
+			wantMax:     0,
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			strategy:    GreedyStrategy,
+			wantError:   DirectedGraphErr{},
+		},
+		{
+			description: "unknown strategy",
+			input:       edgeListGraph(false, [2]int{1, 2}),
+			strategy:    ColoringStrategy(42),
+			wantError:   InvalidArgumentErr{"42", "unknown coloring strategy"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Coloring(test.strategy)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !isProperColoring(test.input, got) {
+					t.Errorf("improper coloring: %v", got)
+				}
+				if n := numColors(got); n > test.wantMax {
+					t.Errorf("%v colors > %v", n, test.wantMax)
+				}
+			}
+		})
+	}
+}
+
+func TestGreedyColoring(t *testing.T) {
+	// A "crown" graph where the interleaved order forces greedy coloring to
+	// use as many colors as there are pairs.
+	crown := edgeListGraph(false,
+		[2]int{1, 4}, [2]int{1, 6}, [2]int{3, 2}, [2]int{3, 6}, [2]int{5, 2}, [2]int{5, 4},
+	)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		order       []int
+		want        map[int]int
+		wantError   error
+	}{
+		{
+			description: "interleaved order",
+			input:       crown,
+			order:       []int{1, 2, 3, 4, 5, 6},
+			want:        map[int]int{1: 0, 2: 0, 3: 1, 4: 1, 5: 2, 6: 2},
+		},
+		{
+			description: "bipartite order",
+			input:       crown,
+			order:       []int{1, 3, 5, 2, 4, 6},
+			want:        map[int]int{1: 0, 3: 0, 5: 0, 2: 1, 4: 1, 6: 1},
+		},
+		{
+			description: "missing vertex",
+			input:       crown,
+			order:       []int{7},
+			wantError:   &MissingVertexErr[int]{},
+		},
+		{
+			description: "duplicate vertex",
+			input:       crown,
+			order:       []int{1, 1},
+			wantError:   InvalidArgumentErr{"1", "vertex appears more than once in order"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.GreedyColoring(test.order)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestIsKColorable(t *testing.T) {
+	// The Grötzsch graph is triangle-free but needs four colors.
+	grotzsch := edgeListGraph(false,
+		[2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 0},
+		[2]int{5, 1}, [2]int{5, 4}, [2]int{6, 0}, [2]int{6, 2}, [2]int{7, 1}, [2]int{7, 3},
+		[2]int{8, 2}, [2]int{8, 4}, [2]int{9, 3}, [2]int{9, 0},
+		[2]int{10, 5}, [2]int{10, 6}, [2]int{10, 7}, [2]int{10, 8}, [2]int{10, 9},
+	)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		k           int
+		want        bool
+		wantError   error
+	}{
+		{
+			description: "odd cycle with two colors",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{5, 1}),
+			k:           2,
+			want:        false,
+		},
+		{
+			description: "odd cycle with three colors",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{5, 1}),
+			k:           3,
+			want:        true,
+		},
+		{
+			description: "grotzsch graph with three colors",
+			input:       grotzsch,
+			k:           3,
+			want:        false,
+		},
+		{
+			description: "grotzsch graph with four colors",
+			input:       grotzsch,
+			k:           4,
+			want:        true,
+		},
+		{
+			description: "empty graph with zero colors",
+			input:       NewGraph[int](false),
+			k:           0,
+			want:        true,
+		},
+		{
+			description: "negative k",
+			input:       edgeListGraph(false, [2]int{1, 2}),
+			k:           -1,
+			wantError:   InvalidArgumentErr{"-1", "k must be non-negative"},
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			k:           2,
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, colors, err := test.input.IsKColorable(context.Background(), test.k)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != test.want {
+					t.Errorf("%v != %v", got, test.want)
+				}
+				if got {
+					if !isProperColoring(test.input, colors) {
+						t.Errorf("improper coloring: %v", colors)
+					}
+					if n := numColors(colors); n > test.k {
+						t.Errorf("%v colors > %v", n, test.k)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestChromaticNumber(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        int
+		wantError   error
+	}{
+		{
+			description: "empty graph",
+			input:       NewGraph[int](false),
+			want:        0,
+		},
+		{
+			description: "isolated vertices",
+			input: Graph[int]{
+				vertices: set[int]{1: true, 2: true},
+				adjacencyMap: adjacencyMap[int]{
+					1: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{}, Implicit: edgeMap[int]{}},
+					2: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{}, Implicit: edgeMap[int]{}},
+				},
+			},
+			want: 1,
+		},
+		{
+			description: "complete graph K4",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4}),
+			want:        4,
+		},
+		{
+			description: "wheel with odd rim",
+			input: edgeListGraph(false,
+				[2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{5, 1},
+				[2]int{0, 1}, [2]int{0, 2}, [2]int{0, 3}, [2]int{0, 4}, [2]int{0, 5},
+			),
+			want: 4,
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, colors, err := test.input.ChromaticNumber(context.Background())
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != test.want {
+					t.Errorf("%v != %v", got, test.want)
+				}
+				if !isProperColoring(test.input, colors) {
+					t.Errorf("improper coloring: %v", colors)
+				}
+				if n := numColors(colors); n != test.want {
+					t.Errorf("%v colors != %v", n, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestChromaticNumberCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// The Grötzsch graph is triangle-free, so the clique bound leaves work for
+	// the exact search.
+	g := edgeListGraph(false,
+		[2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 0},
+		[2]int{5, 1}, [2]int{5, 4}, [2]int{6, 0}, [2]int{6, 2}, [2]int{7, 1}, [2]int{7, 3},
+		[2]int{8, 2}, [2]int{8, 4}, [2]int{9, 3}, [2]int{9, 0},
+		[2]int{10, 5}, [2]int{10, 6}, [2]int{10, 7}, [2]int{10, 8}, [2]int{10, 9},
+	)
+
+	_, _, err := g.ChromaticNumber(ctx)
+	if !cmp.Equal(err, context.Canceled, cmpopts.EquateErrors()) {
+		t.Errorf("%#v != %#v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,160 @@
+// Package community partitions the vertices of a graph into communities:
+// groups of vertices that are more densely connected to each other than to the
+// rest of the graph.
+//
+// A partition is returned as a map from each vertex to a community number.
+// Communities are numbered consecutively from 0.
+package community
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	graph "github.com/subpop/go-graph"
+)
+
+// A NegativeWeightErr describes an edge with a negative weight. Community
+// detection treats edge weights as connection strengths, which cannot be
+// negative.
+type NegativeWeightErr[V comparable] struct {
+	from, to V
+	weight   float64
+}
+
+func (e *NegativeWeightErr[V]) Error() string {
+	return fmt.Sprintf("err: negative edge weight (%v - %v): %v", e.from, e.to, e.weight)
+}
+
+func (e *NegativeWeightErr[V]) Is(target error) bool {
+	return reflect.TypeOf(e) == reflect.TypeOf(target)
+}
+
+// An arc is a weighted connection from one node of a network to another.
+type arc struct {
+	to     int
+	weight float64
+}
+
+// A network is a weighted undirected graph over the nodes 0..n-1. The arcs of
+// each node are sorted by destination, so that sums over them do not depend on
+// map iteration order, and self-loops are kept separately in loops.
+type network struct {
+	arcs    [][]arc
+	loops   []float64
+	degrees []float64
+	total   float64
+}
+
+// newNetwork converts g into a network. The vertices are ordered by their
+// string representation and returned, so that the nth vertex is node n.
+func newNetwork[V comparable](g *graph.Graph[V]) ([]V, *network, error) {
+	if g.IsDirected() {
+		return nil, nil, graph.DirectedGraphErr{}
+	}
+
+	vertices, index := sortedVertices(g)
+
+	weights := make([]map[int]float64, len(vertices))
+	for i := range weights {
+		weights[i] = make(map[int]float64)
+	}
+	loops := make([]float64, len(vertices))
+	for _, e := range g.GetAllEdges() {
+		if e.Weight < 0 {
+			return nil, nil, &NegativeWeightErr[V]{e.From, e.To, e.Weight}
+		}
+		i, j := index[e.From], index[e.To]
+		if i == j {
+			loops[i] += e.Weight
+		} else {
+			weights[i][j] += e.Weight
+			weights[j][i] += e.Weight
+		}
+	}
+
+	return vertices, buildNetwork(weights, loops), nil
+}
+
+// sortedVertices returns the vertices of g ordered by their string
+// representation, which gives the algorithms a stable order to work in, and
+// the position of each vertex in that order.
+func sortedVertices[V comparable](g *graph.Graph[V]) ([]V, map[V]int) {
+	vertices := g.GetAllVertices()
+	keys := make(map[V]string, len(vertices))
+	for _, v := range vertices {
+		keys[v] = fmt.Sprintf("%v", v)
+	}
+	sort.Slice(vertices, func(i, j int) bool {
+		return keys[vertices[i]] < keys[vertices[j]]
+	})
+	index := make(map[V]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	return vertices, index
+}
+
+// buildNetwork creates a network from symmetric weights between distinct
+// nodes and the self-loop weight of every node.
+func buildNetwork(weights []map[int]float64, loops []float64) *network {
+	net := &network{
+		arcs:    make([][]arc, len(weights)),
+		loops:   loops,
+		degrees: make([]float64, len(weights)),
+	}
+	for i, neighbors := range weights {
+		net.arcs[i] = make([]arc, 0, len(neighbors))
+		for j, weight := range neighbors {
+			net.arcs[i] = append(net.arcs[i], arc{j, weight})
+			net.degrees[i] += weight
+		}
+		sort.Slice(net.arcs[i], func(a, b int) bool {
+			return net.arcs[i][a].to < net.arcs[i][b].to
+		})
+
+		// A self-loop contributes its weight to both of its endpoints.
+		net.degrees[i] += 2 * loops[i]
+		net.total += loops[i]
+		for _, a := range net.arcs[i] {
+			if a.to > i {
+				net.total += a.weight
+			}
+		}
+	}
+
+	return net
+}
+
+// renumber relabels communities consecutively from 0, in the order in which
+// they first appear, and returns the number of communities.
+func renumber(communities []int) int {
+	labels := make(map[int]int)
+	for i, c := range communities {
+		label, ok := labels[c]
+		if !ok {
+			label = len(labels)
+			labels[c] = label
+		}
+		communities[i] = label
+	}
+
+	return len(labels)
+}
+
+// partition maps each vertex of g to the community of its node, and returns
+// the modularity of the result.
+func partition[V comparable](g *graph.Graph[V], vertices []V, communities []int) (map[V]int, float64, error) {
+	p := make(map[V]int, len(vertices))
+	for i, v := range vertices {
+		p[v] = communities[i]
+	}
+
+	q, err := g.Modularity(p)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return p, q, nil
+}
@@ -0,0 +1,111 @@
+package community
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	graph "github.com/subpop/go-graph"
+)
+
+type weightedEdge struct {
+	from, to int
+	weight   float64
+}
+
+// weightedGraph returns an undirected graph with the given edges.
+func weightedGraph(edges ...weightedEdge) *graph.Graph[int] {
+	g := graph.NewGraph[int](false)
+	for _, e := range edges {
+		_ = g.AddEdge(e.from, e.to, e.weight)
+	}
+	return &g
+}
+
+// twoCliques returns two 4-cliques on 0-3 and 4-7, joined by the edge 3-4.
+func twoCliques() *graph.Graph[int] {
+	edges := []weightedEdge{{3, 4, 1}}
+	for _, offset := range []int{0, 4} {
+		for i := 0; i < 4; i++ {
+			for j := i + 1; j < 4; j++ {
+				edges = append(edges, weightedEdge{offset + i, offset + j, 1})
+			}
+		}
+	}
+	return weightedGraph(edges...)
+}
+
+// weightedSquare returns the cycle 0-1-2-3 with heavy edges 0-1 and 2-3.
+func weightedSquare() *graph.Graph[int] {
+	return weightedGraph(
+		weightedEdge{0, 1, 10},
+		weightedEdge{1, 2, 1},
+		weightedEdge{2, 3, 10},
+		weightedEdge{3, 0, 1},
+	)
+}
+
+// groups returns the communities of partition as sorted vertex lists, ordered
+// by their smallest vertex, so that partitions can be compared regardless of
+// community numbering.
+func groups(partition map[int]int) [][]int {
+	members := make(map[int][]int)
+	for v, c := range partition {
+		members[c] = append(members[c], v)
+	}
+	result := make([][]int, 0, len(members))
+	for _, vertices := range members {
+		sort.Ints(vertices)
+		result = append(result, vertices)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i][0] < result[j][0] })
+	return result
+}
+
+// isConsecutive returns true if the communities of partition are numbered
+// from 0 without gaps.
+func isConsecutive(partition map[int]int) bool {
+	seen := make(map[int]bool)
+	for _, c := range partition {
+		seen[c] = true
+	}
+	for c := 0; c < len(seen); c++ {
+		if !seen[c] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNewNetwork(t *testing.T) {
+	directed := graph.NewGraph[int](true)
+	_ = directed.AddEdge(0, 1, 1)
+
+	tests := []struct {
+		description string
+		input       *graph.Graph[int]
+		wantError   error
+	}{
+		{
+			description: "directed",
+			input:       &directed,
+			wantError:   graph.DirectedGraphErr{},
+		},
+		{
+			description: "negative weight",
+			input:       weightedGraph(weightedEdge{0, 1, -1}),
+			wantError:   &NegativeWeightErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			_, _, err := newNetwork(test.input)
+
+			if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+				t.Errorf("%#v != %#v", err, test.wantError)
+			}
+		})
+	}
+}
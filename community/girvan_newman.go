@@ -0,0 +1,170 @@
+package community
+
+import graph "github.com/subpop/go-graph"
+
+// A Level is one level of a Girvan–Newman dendrogram: the partition that
+// results from splitting one community of the previous level in two.
+type Level[V comparable] struct {
+	// Communities maps every vertex to its community. A community keeps its
+	// number from one level to the next until it splits.
+	Communities map[V]int
+
+	// Parent is the community of the previous level that split. The half
+	// containing its first vertex keeps the number Parent, and the other
+	// half is numbered Child, one more than the largest number in the
+	// previous level. Both are -1 in the first level.
+	Parent, Child int
+
+	// Removed lists the edges removed since the previous level, in order of
+	// removal. The last of them disconnected Parent.
+	Removed []graph.Edge[V]
+
+	// Modularity is the modularity of Communities in g.
+	Modularity float64
+}
+
+// GirvanNewman returns an iterator over the levels of the Girvan–Newman
+// dendrogram of an undirected graph (Girvan and Newman, 2002). The first level
+// partitions g into its connected components. Each following level removes
+// edges of highest betweenness, recomputed after every removal, until one more
+// community falls apart, so that the last level places every vertex in a
+// community of its own. Edges of equal betweenness are removed in the order
+// given by the string representation of their endpoints. Betweenness counts
+// shortest paths in hops, so edge weights only contribute to the modularity of
+// each level, and self-loops are never removed. Each step recomputes edge
+// betweenness over the whole graph, which makes the full dendrogram cost
+// O(m²n) time; stop iterating once a level is good enough. If g is directed,
+// it returns DirectedGraphErr. If an edge has a negative weight, it returns
+// NegativeWeightErr.
+func GirvanNewman[V comparable](g *graph.Graph[V]) (func(yield func(Level[V]) bool), error) {
+	if _, _, err := newNetwork(g); err != nil {
+		return nil, err
+	}
+
+	return func(yield func(Level[V]) bool) {
+		vertices, index := sortedVertices(g)
+
+		h := graph.NewGraph[V](false)
+		for _, v := range vertices {
+			_ = h.AddVertex(v)
+		}
+		for _, e := range g.GetAllEdges() {
+			if e.From != e.To {
+				_ = h.AddEdge(e.From, e.To, 1)
+			}
+		}
+
+		communities := make(map[V]int, len(vertices))
+		next := 0
+		for _, v := range vertices {
+			if _, ok := communities[v]; ok {
+				continue
+			}
+			for u := range reachable(&h, v) {
+				communities[u] = next
+			}
+			next++
+		}
+		if !yield(newLevel(g, communities, -1, -1, nil)) {
+			return
+		}
+
+		removed := make([]graph.Edge[V], 0)
+		for {
+			scores, _ := h.EdgeBetweenness(graph.BetweennessWorkers(1))
+			if len(scores) == 0 {
+				return
+			}
+			e := maxEdge(scores, index)
+			_ = h.RemoveEdge(e.From, e.To)
+			removed = append(removed, e)
+
+			half := reachable(&h, e.From)
+			if half[e.To] {
+				continue
+			}
+
+			// The half that contains the first vertex of the community keeps
+			// its number.
+			parent := communities[e.From]
+			var first V
+			for _, v := range vertices {
+				if communities[v] == parent {
+					first = v
+					break
+				}
+			}
+			for _, v := range vertices {
+				if communities[v] == parent && half[v] != half[first] {
+					communities[v] = next
+				}
+			}
+			if !yield(newLevel(g, communities, parent, next, removed)) {
+				return
+			}
+			next++
+			removed = make([]graph.Edge[V], 0)
+		}
+	}, nil
+}
+
+// newLevel returns a Level holding a copy of communities, scored against g.
+func newLevel[V comparable](g *graph.Graph[V], communities map[V]int, parent, child int, removed []graph.Edge[V]) Level[V] {
+	level := Level[V]{
+		Communities: make(map[V]int, len(communities)),
+		Parent:      parent,
+		Child:       child,
+		Removed:     removed,
+	}
+	for v, c := range communities {
+		level.Communities[v] = c
+	}
+	level.Modularity, _ = g.Modularity(level.Communities)
+
+	return level
+}
+
+// reachable returns the set of vertices reachable from v in g.
+func reachable[V comparable](g *graph.Graph[V], v V) map[V]bool {
+	seen := map[V]bool{v: true}
+	stack := []V{v}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		neighbors, _ := g.Neighbors(u, graph.NoDirection)
+		for _, w := range neighbors {
+			if !seen[w] {
+				seen[w] = true
+				stack = append(stack, w)
+			}
+		}
+	}
+
+	return seen
+}
+
+// maxEdge returns the edge with the highest score. Scores within a small
+// relative tolerance of each other are considered equal, since summing the
+// same path counts in a different order can change the last bits, and ties
+// go to the edge whose endpoints come first in index.
+func maxEdge[V comparable](scores map[graph.Edge[V]]float64, index map[V]int) graph.Edge[V] {
+	const tolerance = 1e-9
+
+	var best graph.Edge[V]
+	bestScore := -1.0
+	for e, score := range scores {
+		if score > bestScore*(1+tolerance) || (score >= bestScore*(1-tolerance) && edgeLess(e, best, index)) {
+			best, bestScore = e, score
+		}
+	}
+
+	return best
+}
+
+// edgeLess orders edges by the index of their endpoints.
+func edgeLess[V comparable](a, b graph.Edge[V], index map[V]int) bool {
+	if index[a.From] != index[b.From] {
+		return index[a.From] < index[b.From]
+	}
+	return index[a.To] < index[b.To]
+}
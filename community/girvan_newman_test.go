@@ -0,0 +1,158 @@
+package community
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	graph "github.com/subpop/go-graph"
+)
+
+// twoTriangles returns the triangles 0-1-2 and 3-4-5 joined by the edge 2-3.
+func twoTriangles() *graph.Graph[int] {
+	return weightedGraph(
+		weightedEdge{0, 1, 1}, weightedEdge{1, 2, 1}, weightedEdge{2, 0, 1},
+		weightedEdge{3, 4, 1}, weightedEdge{4, 5, 1}, weightedEdge{5, 3, 1},
+		weightedEdge{2, 3, 1},
+	)
+}
+
+func TestGirvanNewman(t *testing.T) {
+	directed := graph.NewGraph[int](true)
+	_ = directed.AddEdge(0, 1, 1)
+
+	tests := []struct {
+		description string
+		input       *graph.Graph[int]
+		levels      int
+		want        []Level[int]
+		wantError   error
+	}{
+		{
+			description: "two triangles",
+			input:       twoTriangles(),
+			levels:      3,
+			want: []Level[int]{
+				{
+					Communities: map[int]int{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+					Parent:      -1,
+					Child:       -1,
+					Modularity:  0,
+				},
+				{
+					Communities: map[int]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1},
+					Parent:      0,
+					Child:       1,
+					Removed:     []graph.Edge[int]{{From: 2, To: 3}},
+					Modularity:  6.0/7 - 0.5,
+				},
+				{
+					Communities: map[int]int{0: 0, 1: 2, 2: 2, 3: 1, 4: 1, 5: 1},
+					Parent:      0,
+					Child:       2,
+					Removed:     []graph.Edge[int]{{From: 0, To: 1}, {From: 0, To: 2}},
+					Modularity:  34.0 / 196,
+				},
+			},
+		},
+		{
+			description: "disconnected",
+			input:       weightedGraph(weightedEdge{0, 1, 1}, weightedEdge{2, 3, 1}),
+			levels:      2,
+			want: []Level[int]{
+				{
+					Communities: map[int]int{0: 0, 1: 0, 2: 1, 3: 1},
+					Parent:      -1,
+					Child:       -1,
+					Modularity:  0.5,
+				},
+				{
+					Communities: map[int]int{0: 0, 1: 2, 2: 1, 3: 1},
+					Parent:      0,
+					Child:       2,
+					Removed:     []graph.Edge[int]{{From: 0, To: 1}},
+					Modularity:  0.125,
+				},
+			},
+		},
+		{
+			description: "directed",
+			input:       &directed,
+			wantError:   graph.DirectedGraphErr{},
+		},
+		{
+			description: "negative weight",
+			input:       weightedGraph(weightedEdge{0, 1, -1}),
+			wantError:   &NegativeWeightErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			levels, err := GirvanNewman(test.input)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := make([]Level[int], 0)
+				levels(func(level Level[int]) bool {
+					got = append(got, level)
+					return len(got) < test.levels
+				})
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9), cmpopts.EquateEmpty()) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGirvanNewmanDendrogram(t *testing.T) {
+	g := ringOfCliques(4, 4)
+	_ = g.AddEdge(0, 0, 1)
+	_ = g.AddVertex(16)
+
+	levels, err := GirvanNewman(g)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var previous map[int]int
+	count := 0
+	levels(func(level Level[int]) bool {
+		count++
+		if !isConsecutive(level.Communities) {
+			t.Errorf("communities of %v are not numbered consecutively", level.Communities)
+		}
+		if previous != nil {
+			// Only Parent may change, and only by moving vertices to Child.
+			for v, c := range level.Communities {
+				if c != previous[v] && (previous[v] != level.Parent || c != level.Child) {
+					t.Errorf("vertex %v moved from %v to %v, want from %v to %v", v, previous[v], c, level.Parent, level.Child)
+				}
+			}
+			if level.Child != len(groups(previous)) {
+				t.Errorf("%v != %v", level.Child, len(groups(previous)))
+			}
+			if len(level.Removed) == 0 {
+				t.Errorf("no edges removed between levels")
+			}
+		}
+		previous = level.Communities
+		return true
+	})
+
+	// Two components to start with, and one more per level until every
+	// vertex is alone.
+	if count != 16 {
+		t.Errorf("%v != %v", count, 16)
+	}
+	if len(groups(previous)) != 17 {
+		t.Errorf("%v != %v", len(groups(previous)), 17)
+	}
+}
@@ -0,0 +1,76 @@
+package community
+
+import (
+	"math/rand"
+	"sort"
+
+	graph "github.com/subpop/go-graph"
+)
+
+// LabelPropagation partitions the vertices of an undirected graph by
+// asynchronous label propagation (Raghavan et al., 2007), and returns the
+// partition together with its modularity. Every vertex starts with a label of
+// its own. In each round, the vertices are visited in a pseudo-random order and
+// each adopts the label with the largest total edge weight among its
+// neighbors, keeping its current label if that is one of the best and
+// otherwise breaking ties at random. It stops when every vertex carries one of
+// the best labels of its neighborhood, and the vertices sharing a label form a
+// community. The generator is seeded with seed, so the same seed on the same
+// graph gives the same result. Label propagation runs in near-linear time but,
+// unlike Louvain, does not optimize modularity directly. If g is directed, it
+// returns DirectedGraphErr. If an edge has a negative weight, it returns
+// NegativeWeightErr.
+func LabelPropagation[V comparable](g *graph.Graph[V], seed int64) (map[V]int, float64, error) {
+	vertices, net, err := newNetwork(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	labels := make([]int, len(vertices))
+	for i := range labels {
+		labels[i] = i
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, i := range r.Perm(len(labels)) {
+			best := net.bestLabels(i, labels)
+			if len(best) == 0 {
+				continue
+			}
+			if j := sort.SearchInts(best, labels[i]); j < len(best) && best[j] == labels[i] {
+				continue
+			}
+			labels[i] = best[r.Intn(len(best))]
+			changed = true
+		}
+	}
+
+	renumber(labels)
+
+	return partition(g, vertices, labels)
+}
+
+// bestLabels returns, in increasing order, the labels that carry the largest
+// total edge weight among the neighbors of node i.
+func (net *network) bestLabels(i int, labels []int) []int {
+	weights := make(map[int]float64)
+	for _, a := range net.arcs[i] {
+		weights[labels[a.to]] += a.weight
+	}
+
+	best := make([]int, 0)
+	max := 0.0
+	for label, weight := range weights {
+		switch {
+		case len(best) == 0 || weight > max:
+			best, max = append(best[:0], label), weight
+		case weight == max:
+			best = append(best, label)
+		}
+	}
+	sort.Ints(best)
+
+	return best
+}
@@ -0,0 +1,117 @@
+package community
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	graph "github.com/subpop/go-graph"
+)
+
+func TestLabelPropagation(t *testing.T) {
+	isolated := weightedGraph(weightedEdge{0, 1, 1})
+	_ = isolated.AddVertex(2)
+
+	tests := []struct {
+		description    string
+		input          *graph.Graph[int]
+		want           [][]int
+		wantModularity float64
+		wantError      error
+	}{
+		{
+			description:    "two cliques",
+			input:          twoCliques(),
+			want:           [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}},
+			wantModularity: 12.0/13 - 0.5,
+		},
+		{
+			description:    "weighted square",
+			input:          weightedSquare(),
+			want:           [][]int{{0, 1}, {2, 3}},
+			wantModularity: 20.0/22 - 0.5,
+		},
+		{
+			description:    "disconnected triangles",
+			input:          weightedGraph(weightedEdge{0, 1, 1}, weightedEdge{1, 2, 1}, weightedEdge{2, 0, 1}, weightedEdge{3, 4, 1}, weightedEdge{4, 5, 1}, weightedEdge{5, 3, 1}),
+			want:           [][]int{{0, 1, 2}, {3, 4, 5}},
+			wantModularity: 0.5,
+		},
+		{
+			description:    "isolated vertex",
+			input:          isolated,
+			want:           [][]int{{0, 1}, {2}},
+			wantModularity: 0,
+		},
+		{
+			description:    "empty graph",
+			input:          weightedGraph(),
+			want:           [][]int{},
+			wantModularity: 0,
+		},
+		{
+			description: "negative weight",
+			input:       weightedGraph(weightedEdge{0, 1, -1}),
+			wantError:   &NegativeWeightErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, modularity, err := LabelPropagation(test.input, 1)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(groups(got), test.want) {
+					t.Errorf("%v != %v", groups(got), test.want)
+				}
+				if !isConsecutive(got) {
+					t.Errorf("communities of %v are not numbered consecutively", got)
+				}
+				if !cmp.Equal(modularity, test.wantModularity, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", modularity, test.wantModularity)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelPropagationStable(t *testing.T) {
+	g := ringOfCliques(10, 4)
+
+	for seed := int64(0); seed < 20; seed++ {
+		got, _, err := LabelPropagation(g, seed)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Every vertex must end with a label carried by the largest weight
+		// among its neighbors.
+		vertices, net, _ := newNetwork(g)
+		labels := make([]int, len(vertices))
+		for i, v := range vertices {
+			labels[i] = got[v]
+		}
+		for i := range labels {
+			best := net.bestLabels(i, labels)
+			if !contains(best, labels[i]) {
+				t.Errorf("seed %v: vertex %v has label %v, want one of %v", seed, vertices[i], labels[i], best)
+			}
+		}
+	}
+}
+
+func contains(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,122 @@
+package community
+
+import (
+	"math/rand"
+
+	graph "github.com/subpop/go-graph"
+)
+
+// Louvain partitions the vertices of an undirected graph by greedy modularity
+// optimization (Blondel et al., 2008), and returns the partition together with
+// its modularity. Each pass moves single vertices to the neighboring community
+// that increases modularity the most, until no move helps, and then merges
+// each community into a single vertex for the next pass. It stops when a pass
+// moves nothing. Edge weights are connection strengths and must be
+// non-negative; if every edge has weight 0, each vertex is its own community.
+// Vertices are visited in a pseudo-random order drawn from a generator seeded
+// with seed, so the same seed on the same graph gives the same result. If g is
+// directed, it returns DirectedGraphErr. If an edge has a negative weight, it
+// returns NegativeWeightErr.
+func Louvain[V comparable](g *graph.Graph[V], seed int64) (map[V]int, float64, error) {
+	vertices, net, err := newNetwork(g)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	r := rand.New(rand.NewSource(seed))
+	communities := make([]int, len(vertices))
+	for i := range communities {
+		communities[i] = i
+	}
+
+	level := net
+	for {
+		moved, ok := level.moveNodes(r)
+		if !ok {
+			break
+		}
+		n := renumber(moved)
+		for i, c := range communities {
+			communities[i] = moved[c]
+		}
+		level = level.aggregate(moved, n)
+	}
+
+	return partition(g, vertices, communities)
+}
+
+// moveNodes runs the local moving phase of Louvain, starting from every node
+// in a community of its own. It returns the community of every node, and
+// whether any node moved.
+func (net *network) moveNodes(r *rand.Rand) ([]int, bool) {
+	n := len(net.arcs)
+	communities := make([]int, n)
+	totals := make([]float64, n)
+	for i := range communities {
+		communities[i] = i
+		totals[i] = net.degrees[i]
+	}
+	if net.total == 0 {
+		return communities, false
+	}
+
+	m2 := 2 * net.total
+	order := r.Perm(n)
+	moved := false
+	for improved := true; improved; {
+		improved = false
+		for _, i := range order {
+			links := make(map[int]float64)
+			for _, a := range net.arcs[i] {
+				links[communities[a.to]] += a.weight
+			}
+
+			// Take i out of its community, then put it into the community
+			// that gains the most modularity from it. The gain of joining c
+			// is proportional to links[c] - totals[c]*degrees[i]/2m.
+			current := communities[i]
+			totals[current] -= net.degrees[i]
+			best := current
+			bestGain := links[current] - totals[current]*net.degrees[i]/m2
+			for _, a := range net.arcs[i] {
+				c := communities[a.to]
+				gain := links[c] - totals[c]*net.degrees[i]/m2
+				if gain > bestGain {
+					best, bestGain = c, gain
+				}
+			}
+			totals[best] += net.degrees[i]
+			communities[i] = best
+
+			if best != current {
+				improved, moved = true, true
+			}
+		}
+	}
+
+	return communities, moved
+}
+
+// aggregate returns the network whose nodes are the n communities of net:
+// edge weight between communities is summed, and edge weight within a
+// community becomes a self-loop.
+func (net *network) aggregate(communities []int, n int) *network {
+	weights := make([]map[int]float64, n)
+	for c := range weights {
+		weights[c] = make(map[int]float64)
+	}
+	loops := make([]float64, n)
+	for i, c := range communities {
+		loops[c] += net.loops[i]
+		for _, a := range net.arcs[i] {
+			d := communities[a.to]
+			if d != c {
+				weights[c][d] += a.weight
+			} else if a.to > i {
+				loops[c] += a.weight
+			}
+		}
+	}
+
+	return buildNetwork(weights, loops)
+}
@@ -0,0 +1,121 @@
+package community
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	graph "github.com/subpop/go-graph"
+)
+
+// ringOfCliques returns n k-cliques, each joined to the next by a single edge.
+func ringOfCliques(n, k int) *graph.Graph[int] {
+	edges := make([]weightedEdge, 0)
+	for c := 0; c < n; c++ {
+		for i := 0; i < k; i++ {
+			for j := i + 1; j < k; j++ {
+				edges = append(edges, weightedEdge{c*k + i, c*k + j, 1})
+			}
+		}
+		edges = append(edges, weightedEdge{c*k + k - 1, (c + 1) % n * k, 1})
+	}
+	return weightedGraph(edges...)
+}
+
+func TestLouvain(t *testing.T) {
+	isolated := weightedGraph(weightedEdge{0, 1, 1})
+	_ = isolated.AddVertex(2)
+
+	tests := []struct {
+		description    string
+		input          *graph.Graph[int]
+		want           [][]int
+		wantModularity float64
+		wantError      error
+	}{
+		{
+			description:    "two cliques",
+			input:          twoCliques(),
+			want:           [][]int{{0, 1, 2, 3}, {4, 5, 6, 7}},
+			wantModularity: 12.0/13 - 0.5,
+		},
+		{
+			description:    "weighted square",
+			input:          weightedSquare(),
+			want:           [][]int{{0, 1}, {2, 3}},
+			wantModularity: 20.0/22 - 0.5,
+		},
+		{
+			description:    "ring of cliques",
+			input:          ringOfCliques(6, 5),
+			want:           [][]int{{0, 1, 2, 3, 4}, {5, 6, 7, 8, 9}, {10, 11, 12, 13, 14}, {15, 16, 17, 18, 19}, {20, 21, 22, 23, 24}, {25, 26, 27, 28, 29}},
+			wantModularity: 6 * (10.0/66 - (22.0/132)*(22.0/132)),
+		},
+		{
+			description:    "isolated vertex",
+			input:          isolated,
+			want:           [][]int{{0, 1}, {2}},
+			wantModularity: 0,
+		},
+		{
+			description:    "no edge weight",
+			input:          weightedGraph(weightedEdge{0, 1, 0}, weightedEdge{1, 2, 0}),
+			want:           [][]int{{0}, {1}, {2}},
+			wantModularity: 0,
+		},
+		{
+			description:    "empty graph",
+			input:          weightedGraph(),
+			want:           [][]int{},
+			wantModularity: 0,
+		},
+		{
+			description: "negative weight",
+			input:       weightedGraph(weightedEdge{0, 1, -1}),
+			wantError:   &NegativeWeightErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, modularity, err := Louvain(test.input, 1)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(groups(got), test.want) {
+					t.Errorf("%v != %v", groups(got), test.want)
+				}
+				if !isConsecutive(got) {
+					t.Errorf("communities of %v are not numbered consecutively", got)
+				}
+				if !cmp.Equal(modularity, test.wantModularity, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", modularity, test.wantModularity)
+				}
+			}
+		})
+	}
+}
+
+func TestLouvainSeed(t *testing.T) {
+	g := ringOfCliques(12, 3)
+
+	want, wantModularity, err := Louvain(g, 42)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		got, modularity, err := Louvain(g, 42)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !cmp.Equal(got, want) || modularity != wantModularity {
+			t.Errorf("%v, %v != %v, %v", got, modularity, want, wantModularity)
+		}
+	}
+}
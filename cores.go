@@ -0,0 +1,113 @@
+package graph
+
+// CoreNumbers returns the core number of every vertex of an undirected graph.
+// The k-core of a graph is its largest subgraph in which every vertex has
+// degree at least k; the core number of a vertex is the largest k for which it
+// belongs to the k-core. Self-loops are ignored. If the graph is directed, it
+// returns DirectedGraphErr.
+func (g *Graph[V]) CoreNumbers() (map[V]int, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	_, cores := g.coreDecomposition()
+
+	return cores, nil
+}
+
+// DegeneracyOrdering returns the vertices of an undirected graph in the order
+// in which they are removed when repeatedly deleting a vertex of minimum
+// degree. Every vertex has at most d neighbors that come after it, where d is
+// the degeneracy of the graph (its largest core number). Self-loops are
+// ignored. If the graph is directed, it returns DirectedGraphErr.
+func (g *Graph[V]) DegeneracyOrdering() ([]V, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	order, _ := g.coreDecomposition()
+
+	return order, nil
+}
+
+// coreDecomposition computes the core number of every vertex of an undirected
+// graph along with a degeneracy ordering, using the linear-time bucket
+// algorithm of Batagelj and Zaversnik. Vertices are removed in order of their
+// remaining degree, so each vertex has at most its core number of neighbors
+// later in the ordering. Self-loops are ignored.
+func (g *Graph[V]) coreDecomposition() ([]V, map[V]int) {
+	vertices := g.GetAllVertices()
+	n := len(vertices)
+	index := make(map[V]int, n)
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	degree := make([]int, n)
+	maxDegree := 0
+	for i, v := range vertices {
+		d, _ := g.Degree(v)
+		if _, ok := g.adjacencyMap[v].Explicit[v]; ok {
+			d--
+		}
+		degree[i] = d
+		if d > maxDegree {
+			maxDegree = d
+		}
+	}
+
+	// Sort vertices into bins by degree. bin[d] is the position in order of
+	// the first vertex with degree d.
+	bin := make([]int, maxDegree+1)
+	for _, d := range degree {
+		bin[d]++
+	}
+	start := 0
+	for d := range bin {
+		count := bin[d]
+		bin[d] = start
+		start += count
+	}
+	order := make([]int, n)
+	position := make([]int, n)
+	for i, d := range degree {
+		position[i] = bin[d]
+		order[position[i]] = i
+		bin[d]++
+	}
+	for d := maxDegree; d > 0; d-- {
+		bin[d] = bin[d-1]
+	}
+	bin[0] = 0
+
+	// Remove vertices in order, moving each remaining neighbor with a larger
+	// degree into the next lower bin.
+	for i := 0; i < n; i++ {
+		v := order[i]
+		for u := range g.adjacencyMap[vertices[v]].Explicit {
+			w := index[u]
+			if w == v || degree[w] <= degree[v] {
+				continue
+			}
+			dw := degree[w]
+			pw := position[w]
+			first := bin[dw]
+			if w != order[first] {
+				order[pw], order[first] = order[first], w
+				position[order[pw]] = pw
+				position[w] = first
+			}
+			bin[dw]++
+			degree[w]--
+		}
+	}
+
+	ordering := make([]V, n)
+	cores := make(map[V]int, n)
+	for i, v := range order {
+		ordering[i] = vertices[v]
+		cores[vertices[v]] = degree[v]
+	}
+
+	return ordering, cores
+}
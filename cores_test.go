@@ -0,0 +1,134 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestCoreNumbers(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        map[int]int
+		wantError   error
+	}{
+		{
+			description: "K4 with a pendant path",
+			input: edgeListGraph(false,
+				[2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4},
+				[2]int{4, 5}, [2]int{5, 6},
+			),
+			want: map[int]int{1: 3, 2: 3, 3: 3, 4: 3, 5: 1, 6: 1},
+		},
+		{
+			description: "cycle with chord and tail",
+			input: edgeListGraph(false,
+				[2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 1}, [2]int{1, 3},
+				[2]int{4, 5}, [2]int{5, 1}, [2]int{6, 5},
+			),
+			want: map[int]int{1: 2, 2: 2, 3: 2, 4: 2, 5: 2, 6: 1},
+		},
+		{
+			description: "isolated vertex and self-loop",
+			input: Graph[int]{
+				vertices: set[int]{1: true, 2: true, 3: true},
+				adjacencyMap: adjacencyMap[int]{
+					1: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{1: 1, 2: 1}, Implicit: edgeMap[int]{}},
+					2: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{1: 1}, Implicit: edgeMap[int]{}},
+					3: struct{ Explicit, Implicit edgeMap[int] }{Explicit: edgeMap[int]{}, Implicit: edgeMap[int]{}},
+				},
+			},
+			want: map[int]int{1: 1, 2: 1, 3: 0},
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.CoreNumbers()
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDegeneracyOrdering(t *testing.T) {
+	tests := []struct {
+		description    string
+		input          Graph[int]
+		wantDegeneracy int
+		wantError      error
+	}{
+		{
+			description: "K4 with a pendant path",
+			input: edgeListGraph(false,
+				[2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4},
+				[2]int{4, 5}, [2]int{5, 6},
+			),
+			wantDegeneracy: 3,
+		},
+		{
+			description: "tree",
+			input: edgeListGraph(false,
+				[2]int{1, 2}, [2]int{1, 3}, [2]int{2, 4}, [2]int{2, 5}, [2]int{3, 6},
+			),
+			wantDegeneracy: 1,
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.DegeneracyOrdering()
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if len(got) != test.input.NumVertex() {
+					t.Fatalf("%v: ordering has %v vertices, want %v", got, len(got), test.input.NumVertex())
+				}
+				position := make(map[int]int)
+				for i, v := range got {
+					position[v] = i
+				}
+				for i, v := range got {
+					later := 0
+					for n := range test.input.adjacencyMap[v].Explicit {
+						if position[n] > i {
+							later++
+						}
+					}
+					if later > test.wantDegeneracy {
+						t.Errorf("%v: vertex %v has %v later neighbors > %v", got, v, later, test.wantDegeneracy)
+					}
+				}
+			}
+		})
+	}
+}
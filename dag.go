@@ -0,0 +1,198 @@
+package graph
+
+import "math"
+
+// DAGShortestPaths computes the shortest paths from source to all other
+// vertices of a directed acyclic graph by relaxing the edges out of each
+// vertex in topological order. Unlike Dijkstra, it works with negative edge
+// weights. The relaxation takes O(V + E) time, and building the result takes
+// time proportional to the total length of the returned paths, which can be
+// up to O(V²) in a long chain. The result has the same shape as that of
+// Dijkstra: unreachable vertices have a Distance of math.Inf(1) and a nil
+// Path. If several paths are equally short, any one of them may be returned.
+// If source does not exist in the graph, it returns MissingVertexErr. If the
+// graph is undirected, it returns UndirectedGraphErr, and if it has a cycle,
+// CycleDetectedErr.
+func (g *Graph[V]) DAGShortestPaths(source V) (map[V]PathResult[V], error) {
+	return g.dagPaths(source, func(a, b float64) bool { return a < b }, math.Inf(1))
+}
+
+// DAGLongestPaths computes the longest paths from source to all other
+// vertices of a directed acyclic graph in the same way, and with the same
+// running time, as DAGShortestPaths. Unreachable vertices have a Distance of
+// math.Inf(-1) and a nil Path. Errors are reported as by DAGShortestPaths.
+func (g *Graph[V]) DAGLongestPaths(source V) (map[V]PathResult[V], error) {
+	return g.dagPaths(source, func(a, b float64) bool { return a > b }, math.Inf(-1))
+}
+
+// dagPaths relaxes edges in topological order, replacing a distance whenever
+// better reports that a new one improves on it. Vertices not reached from
+// source get the distance unreachable.
+func (g *Graph[V]) dagPaths(source V, better func(a, b float64) bool, unreachable float64) (map[V]PathResult[V], error) {
+	if _, ok := g.vertices[source]; !ok {
+		return nil, &MissingVertexErr[V]{v: source}
+	}
+	order, err := g.TopologicalSort()
+	if err != nil {
+		return nil, err
+	}
+
+	dist := map[V]float64{source: 0}
+	prev := make(map[V]*V)
+	for _, u := range order {
+		if _, ok := dist[u]; !ok {
+			continue
+		}
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			alt := dist[u] + weight
+			if d, ok := dist[v]; !ok || better(alt, d) {
+				dist[v] = alt
+				uCopy := u
+				prev[v] = &uCopy
+			}
+		}
+	}
+
+	results := make(map[V]PathResult[V], len(g.vertices))
+	for v := range g.vertices {
+		if d, ok := dist[v]; ok {
+			results[v] = PathResult[V]{Distance: d, Path: g.reconstructPath(prev, source, v)}
+		} else {
+			results[v] = PathResult[V]{Distance: unreachable, Path: nil}
+		}
+	}
+
+	return results, nil
+}
+
+// A CriticalPathOption configures CriticalPath.
+type CriticalPathOption[V comparable] func(*criticalPathConfig[V])
+
+type criticalPathConfig[V comparable] struct {
+	durations map[V]float64
+}
+
+// VertexDurations gives each vertex the time it takes to complete. Vertices
+// missing from durations take no time.
+func VertexDurations[V comparable](durations map[V]float64) CriticalPathOption[V] {
+	return func(c *criticalPathConfig[V]) {
+		c.durations = durations
+	}
+}
+
+// A CriticalPathResult is a schedule of the vertices of a directed acyclic
+// graph computed by CriticalPath.
+type CriticalPathResult[V comparable] struct {
+	// Length is the time at which the last vertex finishes when every vertex
+	// starts as early as possible.
+	Length float64
+
+	// Path is a critical path: a chain of vertices from one that starts at
+	// time 0 to one that finishes at Length, in which any delay delays the
+	// whole schedule.
+	Path []V
+
+	// EarliestStart is the earliest time each vertex can start.
+	EarliestStart map[V]float64
+
+	// LatestStart is the latest time each vertex can start without making
+	// the schedule longer than Length.
+	LatestStart map[V]float64
+
+	// Slack is the difference between the latest and the earliest start of
+	// each vertex. Vertices on a critical path have no slack.
+	Slack map[V]float64
+}
+
+// CriticalPath schedules the vertices of a directed acyclic graph in which an
+// edge from u to v means that v cannot start until u has finished, plus the
+// weight of the edge. Vertices take no time unless VertexDurations is given,
+// in which case a project whose dependency edges carry no extra delay should
+// give them weight 0. Vertices with no inbound edges start at time 0. Earliest
+// starts are computed in one pass over the vertices in topological order and
+// latest starts in one pass in reverse order, so this takes O(V + E) time. If
+// the graph has several critical paths, any one of them may be returned. If
+// the graph is undirected, it returns UndirectedGraphErr, and if it has a
+// cycle, CycleDetectedErr. If durations has a vertex that is not in the graph,
+// it returns MissingVertexErr.
+func (g *Graph[V]) CriticalPath(opts ...CriticalPathOption[V]) (CriticalPathResult[V], error) {
+	config := criticalPathConfig[V]{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	for v := range config.durations {
+		if _, ok := g.vertices[v]; !ok {
+			return CriticalPathResult[V]{}, &MissingVertexErr[V]{v: v}
+		}
+	}
+	order, err := g.TopologicalSort()
+	if err != nil {
+		return CriticalPathResult[V]{}, err
+	}
+
+	duration := config.durations
+	earliest := make(map[V]float64, len(order))
+	for _, v := range order {
+		earliest[v] = 0
+	}
+	length := 0.0
+	for _, u := range order {
+		finish := earliest[u] + duration[u]
+		length = math.Max(length, finish)
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			earliest[v] = math.Max(earliest[v], finish+weight)
+		}
+	}
+
+	latest := make(map[V]float64, len(order))
+	slack := make(map[V]float64, len(order))
+	for i := len(order) - 1; i >= 0; i-- {
+		u := order[i]
+		finish := length
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			finish = math.Min(finish, latest[v]-weight)
+		}
+		latest[u] = finish - duration[u]
+		slack[u] = latest[u] - earliest[u]
+	}
+
+	// Times are sums of durations and weights, so compare them with a
+	// tolerance for rounding errors.
+	tolerance := 1e-9 * math.Max(1, math.Abs(length))
+	critical := func(v V) bool { return slack[v] <= tolerance }
+
+	// A critical vertex that does not finish at the end of the schedule has
+	// its latest finish set by a critical successor that starts right after
+	// it, so the path can be followed forward from a critical vertex that
+	// starts at time 0.
+	var path []V
+	for _, u := range order {
+		if critical(u) && earliest[u] <= tolerance {
+			path = []V{u}
+			break
+		}
+	}
+	for extended := len(path) > 0; extended; {
+		u := path[len(path)-1]
+		finish := earliest[u] + duration[u]
+		if finish >= length-tolerance {
+			break
+		}
+		extended = false
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			if critical(v) && math.Abs(earliest[v]-(finish+weight)) <= tolerance {
+				path = append(path, v)
+				extended = true
+				break
+			}
+		}
+	}
+
+	return CriticalPathResult[V]{
+		Length:        length,
+		Path:          path,
+		EarliestStart: earliest,
+		LatestStart:   latest,
+		Slack:         slack,
+	}, nil
+}
@@ -0,0 +1,226 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// weightedDAG returns a directed acyclic graph with negative edge weights in
+// which shortest and longest paths from "s" are unique.
+func weightedDAG() Graph[string] {
+	g := NewGraph[string](true)
+	_ = g.AddEdge("r", "s", 5)
+	_ = g.AddEdge("r", "t", 3)
+	_ = g.AddEdge("s", "t", 2)
+	_ = g.AddEdge("s", "x", 6)
+	_ = g.AddEdge("t", "x", 7)
+	_ = g.AddEdge("t", "y", 4)
+	_ = g.AddEdge("t", "z", 2)
+	_ = g.AddEdge("x", "y", -1)
+	_ = g.AddEdge("x", "z", 1)
+	_ = g.AddEdge("y", "z", -2)
+	return g
+}
+
+func TestDAGShortestPaths(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[string]
+		source      string
+		want        map[string]PathResult[string]
+		wantError   error
+	}{
+		{
+			description: "negative weights",
+			input:       weightedDAG(),
+			source:      "s",
+			want: map[string]PathResult[string]{
+				"r": {Distance: math.Inf(1)},
+				"s": {Distance: 0, Path: []string{"s"}},
+				"t": {Distance: 2, Path: []string{"s", "t"}},
+				"x": {Distance: 6, Path: []string{"s", "x"}},
+				"y": {Distance: 5, Path: []string{"s", "x", "y"}},
+				"z": {Distance: 3, Path: []string{"s", "x", "y", "z"}},
+			},
+		},
+		{
+			description: "missing vertex",
+			input:       weightedDAG(),
+			source:      "a",
+			wantError:   &MissingVertexErr[string]{},
+		},
+		{
+			description: "undirected",
+			input:       edgeListGraph(false, [2]string{"a", "b"}),
+			source:      "a",
+			wantError:   &UndirectedGraphErr[string]{},
+		},
+		{
+			description: "cycle",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "a"}),
+			source:      "a",
+			wantError:   &CycleDetectedErr[string]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.DAGShortestPaths(test.source)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDAGLongestPaths(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[string]
+		source      string
+		want        map[string]PathResult[string]
+		wantError   error
+	}{
+		{
+			description: "negative weights",
+			input:       weightedDAG(),
+			source:      "s",
+			want: map[string]PathResult[string]{
+				"r": {Distance: math.Inf(-1)},
+				"s": {Distance: 0, Path: []string{"s"}},
+				"t": {Distance: 2, Path: []string{"s", "t"}},
+				"x": {Distance: 9, Path: []string{"s", "t", "x"}},
+				"y": {Distance: 8, Path: []string{"s", "t", "x", "y"}},
+				"z": {Distance: 10, Path: []string{"s", "t", "x", "z"}},
+			},
+		},
+		{
+			description: "cycle",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}),
+			source:      "a",
+			wantError:   &CycleDetectedErr[string]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.DAGLongestPaths(test.source)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCriticalPath(t *testing.T) {
+	build := NewGraph[string](true)
+	_ = build.AddEdge("a", "b", 0)
+	_ = build.AddEdge("a", "c", 0)
+	_ = build.AddEdge("b", "d", 0)
+	_ = build.AddEdge("c", "d", 0)
+
+	lags := NewGraph[string](true)
+	_ = lags.AddEdge("a", "b", 3)
+	_ = lags.AddEdge("b", "c", 2)
+	_ = lags.AddEdge("a", "c", 1)
+	_ = lags.AddVertex("d")
+
+	tests := []struct {
+		description string
+		input       Graph[string]
+		opts        []CriticalPathOption[string]
+		want        CriticalPathResult[string]
+		wantError   error
+	}{
+		{
+			description: "vertex durations",
+			input:       build,
+			opts:        []CriticalPathOption[string]{VertexDurations(map[string]float64{"a": 3, "b": 2, "c": 4, "d": 1})},
+			want: CriticalPathResult[string]{
+				Length:        8,
+				Path:          []string{"a", "c", "d"},
+				EarliestStart: map[string]float64{"a": 0, "b": 3, "c": 3, "d": 7},
+				LatestStart:   map[string]float64{"a": 0, "b": 5, "c": 3, "d": 7},
+				Slack:         map[string]float64{"a": 0, "b": 2, "c": 0, "d": 0},
+			},
+		},
+		{
+			description: "edge weights",
+			input:       lags,
+			want: CriticalPathResult[string]{
+				Length:        5,
+				Path:          []string{"a", "b", "c"},
+				EarliestStart: map[string]float64{"a": 0, "b": 3, "c": 5, "d": 0},
+				LatestStart:   map[string]float64{"a": 0, "b": 3, "c": 5, "d": 5},
+				Slack:         map[string]float64{"a": 0, "b": 0, "c": 0, "d": 5},
+			},
+		},
+		{
+			description: "empty",
+			input:       NewGraph[string](true),
+			want: CriticalPathResult[string]{
+				EarliestStart: map[string]float64{},
+				LatestStart:   map[string]float64{},
+				Slack:         map[string]float64{},
+			},
+		},
+		{
+			description: "unknown vertex duration",
+			input:       build,
+			opts:        []CriticalPathOption[string]{VertexDurations(map[string]float64{"e": 1})},
+			wantError:   &MissingVertexErr[string]{},
+		},
+		{
+			description: "undirected",
+			input:       edgeListGraph(false, [2]string{"a", "b"}),
+			wantError:   &UndirectedGraphErr[string]{},
+		},
+		{
+			description: "cycle",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "a"}),
+			wantError:   &CycleDetectedErr[string]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.CriticalPath(test.opts...)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package graph
+
+import "math"
+
+// floydWarshallVertices is the largest number of vertices for which
+// eccentricities computes weighted distances with FloydWarshall instead of
+// running Dijkstra from every vertex.
+const floydWarshallVertices = 64
+
+// Eccentricity returns the greatest distance from v to any other vertex. In a
+// directed graph, distances follow outbound edges. Distances are sums of edge
+// weights, found by breadth-first search if every edge has weight 1; negative
+// weights are supported through BellmanFord. A graph with a single vertex
+// gives it an eccentricity of 0. If the graph does not contain vertex v, it
+// returns MissingVertexErr. If some vertex is unreachable from v, it returns
+// DisconnectedGraphErr. If the graph contains a negative cycle, it returns
+// NegativeCycleErr.
+func (g *Graph[V]) Eccentricity(v V) (float64, error) {
+	if _, ok := g.vertices[v]; !ok {
+		return 0, &MissingVertexErr[V]{v}
+	}
+
+	_, negative := g.negativeWeight()
+
+	return g.eccentricity(v, !g.hasUnitWeights(), negative)
+}
+
+// Diameter returns the largest eccentricity of any vertex in the graph, that
+// is, the greatest distance between two vertices. Distances are measured as
+// in Eccentricity. An empty graph has a diameter of 0. If some vertex is
+// unreachable from another (in a directed graph, if the graph is not strongly
+// connected), it returns DisconnectedGraphErr. If the graph contains a
+// negative cycle, it returns NegativeCycleErr.
+func (g *Graph[V]) Diameter() (float64, error) {
+	eccentricities, err := g.eccentricities()
+	if err != nil {
+		return 0, err
+	}
+
+	return maxEccentricity(eccentricities), nil
+}
+
+// Radius returns the smallest eccentricity of any vertex in the graph. An
+// empty graph has a radius of 0. It returns the same errors as Diameter.
+func (g *Graph[V]) Radius() (float64, error) {
+	eccentricities, err := g.eccentricities()
+	if err != nil {
+		return 0, err
+	}
+
+	return minEccentricity(eccentricities), nil
+}
+
+// Center returns the vertices whose eccentricity equals the radius of the
+// graph, ordered by their string representation. It returns the same errors
+// as Diameter.
+func (g *Graph[V]) Center() ([]V, error) {
+	eccentricities, err := g.eccentricities()
+	if err != nil {
+		return nil, err
+	}
+
+	return g.verticesWithEccentricity(eccentricities, minEccentricity(eccentricities)), nil
+}
+
+// Periphery returns the vertices whose eccentricity equals the diameter of
+// the graph, ordered by their string representation. It returns the same
+// errors as Diameter.
+func (g *Graph[V]) Periphery() ([]V, error) {
+	eccentricities, err := g.eccentricities()
+	if err != nil {
+		return nil, err
+	}
+
+	return g.verticesWithEccentricity(eccentricities, maxEccentricity(eccentricities)), nil
+}
+
+// eccentricities returns the eccentricity of every vertex. Weighted distances
+// in small graphs, and distances in graphs with negative weights, come from a
+// single FloydWarshall run; otherwise each vertex runs its own search.
+func (g *Graph[V]) eccentricities() (map[V]float64, error) {
+	_, negative := g.negativeWeight()
+	weighted := !g.hasUnitWeights()
+	eccentricities := make(map[V]float64, len(g.vertices))
+
+	if negative || (weighted && len(g.vertices) <= floydWarshallVertices) {
+		results, err := g.FloydWarshall()
+		if err != nil {
+			return nil, err
+		}
+		for u, row := range results {
+			distances := make(map[V]float64, len(row))
+			for v, result := range row {
+				distances[v] = result.Distance
+			}
+			e, err := farthest(u, distances)
+			if err != nil {
+				return nil, err
+			}
+			eccentricities[u] = e
+		}
+		return eccentricities, nil
+	}
+
+	for v := range g.vertices {
+		e, err := g.eccentricity(v, weighted, negative)
+		if err != nil {
+			return nil, err
+		}
+		eccentricities[v] = e
+	}
+
+	return eccentricities, nil
+}
+
+// eccentricity returns the eccentricity of v, running BellmanFord if the graph
+// has negative weights and shortestDistances otherwise.
+func (g *Graph[V]) eccentricity(v V, weighted, negative bool) (float64, error) {
+	var distances map[V]float64
+	if negative {
+		results, err := g.BellmanFord(v)
+		if err != nil {
+			return 0, err
+		}
+		distances = make(map[V]float64, len(results))
+		for u, result := range results {
+			distances[u] = result.Distance
+		}
+	} else {
+		distances = g.shortestDistances(v, weighted)
+	}
+
+	return farthest(v, distances)
+}
+
+// farthest returns the greatest distance from v to another vertex, or 0 if
+// there is no other vertex. If another vertex is unreachable, it returns
+// DisconnectedGraphErr.
+func farthest[V comparable](v V, distances map[V]float64) (float64, error) {
+	e := math.Inf(-1)
+	for u, distance := range distances {
+		if u != v {
+			e = math.Max(e, distance)
+		}
+	}
+	if math.IsInf(e, 1) {
+		return 0, DisconnectedGraphErr{}
+	}
+	if math.IsInf(e, -1) {
+		return 0, nil
+	}
+
+	return e, nil
+}
+
+// negativeWeight returns a negative edge weight of the graph and true, or 0
+// and false if every edge weight is non-negative.
+func (g *Graph[V]) negativeWeight() (float64, bool) {
+	for _, edges := range g.adjacencyMap {
+		for _, weight := range edges.Explicit {
+			if weight < 0 {
+				return weight, true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+// maxEccentricity returns the largest value in eccentricities, or 0 if it is
+// empty.
+func maxEccentricity[V comparable](eccentricities map[V]float64) float64 {
+	diameter := math.Inf(-1)
+	for _, e := range eccentricities {
+		diameter = math.Max(diameter, e)
+	}
+	if math.IsInf(diameter, -1) {
+		return 0
+	}
+
+	return diameter
+}
+
+// minEccentricity returns the smallest value in eccentricities, or 0 if it is
+// empty.
+func minEccentricity[V comparable](eccentricities map[V]float64) float64 {
+	radius := math.Inf(1)
+	for _, e := range eccentricities {
+		radius = math.Min(radius, e)
+	}
+	if math.IsInf(radius, 1) {
+		return 0
+	}
+
+	return radius
+}
+
+// verticesWithEccentricity returns the vertices whose eccentricity is e,
+// ordered by their string representation.
+func (g *Graph[V]) verticesWithEccentricity(eccentricities map[V]float64, e float64) []V {
+	vertices := make([]V, 0)
+	for _, v := range g.sortedVertices() {
+		if eccentricities[v] == e {
+			vertices = append(vertices, v)
+		}
+	}
+
+	return vertices
+}
@@ -0,0 +1,274 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// weightedPath returns the undirected path 0-1-...-(n-1) in which the edge
+// 0-1 has weight 2 and every other edge has weight 1.
+func weightedPath(n int) Graph[int] {
+	g := NewGraph[int](false)
+	_ = g.AddEdge(0, 1, 2)
+	for i := 1; i < n-1; i++ {
+		_ = g.AddEdge(i, i+1, 1)
+	}
+	return g
+}
+
+// uniformPath returns the undirected path 0-1-...-(n-1) in which every edge
+// has weight w.
+func uniformPath(n int, w float64) Graph[int] {
+	g := NewGraph[int](false)
+	for i := 0; i < n-1; i++ {
+		_ = g.AddEdge(i, i+1, w)
+	}
+	return g
+}
+
+func TestEccentricity(t *testing.T) {
+	negative := NewGraph[int](true)
+	_ = negative.AddEdge(0, 1, -1)
+	_ = negative.AddEdge(1, 0, 2)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		vertex      int
+		want        float64
+		wantError   error
+	}{
+		{
+			description: "end of path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}),
+			vertex:      1,
+			want:        3,
+		},
+		{
+			description: "middle of path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}),
+			vertex:      2,
+			want:        2,
+		},
+		{
+			description: "weighted",
+			input:       weightedPath(4),
+			vertex:      0,
+			want:        4,
+		},
+		{
+			description: "uniform weights",
+			input:       uniformPath(3, 5),
+			vertex:      0,
+			want:        10,
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			vertex:      2,
+			want:        2,
+		},
+		{
+			description: "negative weight",
+			input:       negative,
+			vertex:      0,
+			want:        -1,
+		},
+		{
+			description: "unreachable vertex",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			vertex:      2,
+			wantError:   DisconnectedGraphErr{},
+		},
+		{
+			description: "missing vertex",
+			input:       edgeListGraph(false, [2]int{1, 2}),
+			vertex:      3,
+			wantError:   &MissingVertexErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Eccentricity(test.vertex)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got != test.want {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestDiameter(t *testing.T) {
+	single := NewGraph[int](false)
+	_ = single.AddVertex(1)
+
+	triangle := NewGraph[int](false)
+	_ = triangle.AddEdge(1, 2, 1)
+	_ = triangle.AddEdge(2, 3, 1)
+	_ = triangle.AddEdge(1, 3, 5)
+
+	negative := NewGraph[int](true)
+	_ = negative.AddEdge(0, 1, -1)
+	_ = negative.AddEdge(1, 0, 2)
+
+	negativeCycle := NewGraph[int](false)
+	_ = negativeCycle.AddEdge(0, 1, -1)
+
+	tests := []struct {
+		description   string
+		input         Graph[int]
+		wantDiameter  float64
+		wantRadius    float64
+		wantCenter    []int
+		wantPeriphery []int
+		wantError     error
+	}{
+		{
+			description:   "path",
+			input:         edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}),
+			wantDiameter:  3,
+			wantRadius:    2,
+			wantCenter:    []int{2, 3},
+			wantPeriphery: []int{1, 4},
+		},
+		{
+			description:   "star",
+			input:         edgeListGraph(false, [2]int{1, 2}, [2]int{1, 3}, [2]int{1, 4}),
+			wantDiameter:  2,
+			wantRadius:    1,
+			wantCenter:    []int{1},
+			wantPeriphery: []int{2, 3, 4},
+		},
+		{
+			description:   "weighted",
+			input:         triangle,
+			wantDiameter:  2,
+			wantRadius:    1,
+			wantCenter:    []int{2},
+			wantPeriphery: []int{1, 3},
+		},
+		{
+			description:   "large weighted",
+			input:         weightedPath(70),
+			wantDiameter:  70,
+			wantRadius:    35,
+			wantCenter:    []int{34},
+			wantPeriphery: []int{0, 69},
+		},
+		{
+			description:   "uniform weights",
+			input:         uniformPath(3, 5),
+			wantDiameter:  10,
+			wantRadius:    5,
+			wantCenter:    []int{1},
+			wantPeriphery: []int{0, 2},
+		},
+		{
+			description:   "large uniform weights",
+			input:         uniformPath(70, 2),
+			wantDiameter:  138,
+			wantRadius:    70,
+			wantCenter:    []int{34, 35},
+			wantPeriphery: []int{0, 69},
+		},
+		{
+			description:   "directed cycle",
+			input:         edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			wantDiameter:  2,
+			wantRadius:    2,
+			wantCenter:    []int{1, 2, 3},
+			wantPeriphery: []int{1, 2, 3},
+		},
+		{
+			description:   "negative weight",
+			input:         negative,
+			wantDiameter:  2,
+			wantRadius:    -1,
+			wantCenter:    []int{0},
+			wantPeriphery: []int{1},
+		},
+		{
+			description:   "single vertex",
+			input:         single,
+			wantDiameter:  0,
+			wantRadius:    0,
+			wantCenter:    []int{1},
+			wantPeriphery: []int{1},
+		},
+		{
+			description:   "empty",
+			input:         NewGraph[int](false),
+			wantDiameter:  0,
+			wantRadius:    0,
+			wantCenter:    []int{},
+			wantPeriphery: []int{},
+		},
+		{
+			description: "disconnected",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{3, 4}),
+			wantError:   DisconnectedGraphErr{},
+		},
+		{
+			description: "not strongly connected",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			wantError:   DisconnectedGraphErr{},
+		},
+		{
+			description: "negative cycle",
+			input:       negativeCycle,
+			wantError:   &NegativeCycleErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			diameter, err := test.input.Diameter()
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			radius, err := test.input.Radius()
+			if err != nil {
+				t.Fatal(err)
+			}
+			center, err := test.input.Center()
+			if err != nil {
+				t.Fatal(err)
+			}
+			periphery, err := test.input.Periphery()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diameter != test.wantDiameter {
+				t.Errorf("diameter: %v != %v", diameter, test.wantDiameter)
+			}
+			if radius != test.wantRadius {
+				t.Errorf("radius: %v != %v", radius, test.wantRadius)
+			}
+			if !cmp.Equal(center, test.wantCenter) {
+				t.Errorf("center: %v != %v", center, test.wantCenter)
+			}
+			if !cmp.Equal(periphery, test.wantPeriphery) {
+				t.Errorf("periphery: %v != %v", periphery, test.wantPeriphery)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+package graph
+
+import "github.com/subpop/go-adt"
+
+// EdgeColoring computes a proper edge coloring of an undirected graph, so that
+// no two edges sharing an endpoint are assigned the same color. Colors are
+// numbered from 0. Bipartite graphs are colored with the optimal Δ colors by
+// swapping alternating paths (König's theorem); all other graphs are colored
+// with at most Δ+1 colors using the Misra-Gries algorithm, where Δ is the
+// maximum degree. Each edge appears once in the returned map, oriented as in
+// GetAllEdges. Self-loops are ignored. If the graph is directed, it returns
+// DirectedGraphErr.
+func (g *Graph[V]) EdgeColoring() (map[Edge[V]]int, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	c := newEdgeColorer(g)
+	if _, ok := g.bipartition(); ok {
+		c.colorBipartite()
+	} else {
+		c.colorMisraGries()
+	}
+
+	colors := make(map[Edge[V]]int)
+	for u, edges := range c.color {
+		for v, color := range edges {
+			colors[g.edgeKey(u, v)] = color
+		}
+	}
+
+	return colors, nil
+}
+
+// bipartition splits the vertices of an undirected graph into two sets with no
+// edge inside either set, returning the side of each vertex. If no such split
+// exists, it returns false. Self-loops are ignored.
+func (g *Graph[V]) bipartition() (map[V]bool, bool) {
+	side := make(map[V]bool)
+	for start := range g.vertices {
+		if _, ok := side[start]; ok {
+			continue
+		}
+		side[start] = false
+		queue := adt.NewQueue[V]()
+		_ = queue.Enqueue(start)
+		for queue.Len() > 0 {
+			u := queue.Dequeue()
+			for v := range g.adjacencyMap[*u].Explicit {
+				if v == *u {
+					continue
+				}
+				if s, ok := side[v]; !ok {
+					side[v] = !side[*u]
+					_ = queue.Enqueue(v)
+				} else if s == side[*u] {
+					return nil, false
+				}
+			}
+		}
+	}
+
+	return side, true
+}
+
+// edgeColorer holds a partial edge coloring. For every vertex, at maps each
+// color to the neighbor reached through the edge of that color, and color maps
+// each neighbor to the color of the edge leading to it.
+type edgeColorer[V comparable] struct {
+	g         *Graph[V]
+	maxDegree int
+	at        map[V]map[int]V
+	color     map[V]map[V]int
+}
+
+func newEdgeColorer[V comparable](g *Graph[V]) *edgeColorer[V] {
+	c := &edgeColorer[V]{
+		g:     g,
+		at:    make(map[V]map[int]V, len(g.vertices)),
+		color: make(map[V]map[V]int, len(g.vertices)),
+	}
+	for v := range g.vertices {
+		c.at[v] = make(map[int]V)
+		c.color[v] = make(map[V]int)
+		degree := len(g.adjacencyMap[v].Explicit)
+		if _, ok := g.adjacencyMap[v].Explicit[v]; ok {
+			degree--
+		}
+		if degree > c.maxDegree {
+			c.maxDegree = degree
+		}
+	}
+	return c
+}
+
+func (c *edgeColorer[V]) set(u, v V, color int) {
+	c.at[u][color] = v
+	c.at[v][color] = u
+	c.color[u][v] = color
+	c.color[v][u] = color
+}
+
+func (c *edgeColorer[V]) unset(u, v V) {
+	color := c.color[u][v]
+	delete(c.at[u], color)
+	delete(c.at[v], color)
+	delete(c.color[u], v)
+	delete(c.color[v], u)
+}
+
+func (c *edgeColorer[V]) isFree(v V, color int) bool {
+	_, used := c.at[v][color]
+	return !used
+}
+
+// free returns the smallest color below limit that no edge at v uses.
+func (c *edgeColorer[V]) free(v V, limit int) int {
+	for color := 0; color < limit; color++ {
+		if c.isFree(v, color) {
+			return color
+		}
+	}
+	return -1
+}
+
+// swapPath exchanges colors a and b along the maximal path that starts at v
+// with the edge colored a and alternates between a and b.
+func (c *edgeColorer[V]) swapPath(v V, a, b int) {
+	var path []Edge[V]
+	current, want, next := v, a, b
+	for {
+		n, ok := c.at[current][want]
+		if !ok {
+			break
+		}
+		path = append(path, Edge[V]{From: current, To: n})
+		current, want, next = n, next, want
+	}
+
+	colors := make([]int, len(path))
+	for i, e := range path {
+		colors[i] = c.color[e.From][e.To]
+		c.unset(e.From, e.To)
+	}
+	for i, e := range path {
+		if colors[i] == a {
+			c.set(e.From, e.To, b)
+		} else {
+			c.set(e.From, e.To, a)
+		}
+	}
+}
+
+// uncolored calls fn for each edge that has not been colored yet.
+func (c *edgeColorer[V]) uncolored(fn func(u, v V)) {
+	for u := range c.g.vertices {
+		for v := range c.g.adjacencyMap[u].Explicit {
+			if u == v {
+				continue
+			}
+			if _, ok := c.color[u][v]; !ok {
+				fn(u, v)
+			}
+		}
+	}
+}
+
+// colorBipartite colors a bipartite graph with maxDegree colors. When the
+// smallest free colors at the two endpoints differ, the alternating path from
+// one endpoint cannot reach the other, so swapping its colors frees a common
+// color.
+func (c *edgeColorer[V]) colorBipartite() {
+	c.uncolored(func(u, v V) {
+		a := c.free(u, c.maxDegree)
+		if !c.isFree(v, a) {
+			b := c.free(v, c.maxDegree)
+			c.swapPath(v, a, b)
+		}
+		c.set(u, v, a)
+	})
+}
+
+// colorMisraGries colors a graph with at most maxDegree+1 colors using the
+// fan rotation algorithm of Misra and Gries.
+func (c *edgeColorer[V]) colorMisraGries() {
+	limit := c.maxDegree + 1
+	c.uncolored(func(x, y V) {
+		// Build a maximal fan of x starting at y: every following edge
+		// (x, fan[i]) is colored with a color that is free on fan[i-1].
+		fan := []V{y}
+		inFan := set[V]{y: true}
+		for extended := true; extended; {
+			extended = false
+			last := fan[len(fan)-1]
+			for color := 0; color < limit; color++ {
+				if !c.isFree(last, color) {
+					continue
+				}
+				if z, ok := c.at[x][color]; ok && !inFan[z] {
+					fan = append(fan, z)
+					inFan[z] = true
+					extended = true
+					break
+				}
+			}
+		}
+
+		cx := c.free(x, limit)
+		d := c.free(fan[len(fan)-1], limit)
+		if !c.isFree(x, d) {
+			c.swapPath(x, d, cx)
+		}
+
+		// Find the first fan vertex on which d is free such that the prefix
+		// of the fan ending there is still a fan.
+		w := 0
+		for i := range fan {
+			if i > 0 && !c.isFree(fan[i-1], c.color[x][fan[i]]) {
+				break
+			}
+			if c.isFree(fan[i], d) {
+				w = i
+				break
+			}
+		}
+
+		// Rotate the fan prefix and color the last edge with d.
+		for i := 0; i < w; i++ {
+			color := c.color[x][fan[i+1]]
+			c.unset(x, fan[i+1])
+			c.set(x, fan[i], color)
+		}
+		c.set(x, fan[w], d)
+	})
+}
@@ -0,0 +1,153 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// maxDegree returns the largest degree of an undirected graph, ignoring
+// self-loops.
+func maxDegree[V comparable](g Graph[V]) int {
+	max := 0
+	for v := range g.vertices {
+		degree := 0
+		for n := range g.adjacencyMap[v].Explicit {
+			if n != v {
+				degree++
+			}
+		}
+		if degree > max {
+			max = degree
+		}
+	}
+	return max
+}
+
+// isProperEdgeColoring returns true if every edge of g is colored and no two
+// edges sharing an endpoint have the same color.
+func isProperEdgeColoring[V comparable](g Graph[V], colors map[Edge[V]]int) bool {
+	for v := range g.vertices {
+		used := make(map[int]bool)
+		for n := range g.adjacencyMap[v].Explicit {
+			if n == v {
+				continue
+			}
+			c, ok := colors[g.edgeKey(v, n)]
+			if !ok || used[c] {
+				return false
+			}
+			used[c] = true
+		}
+	}
+	return true
+}
+
+func TestEdgeColoring(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		wantMax     int
+		wantError   error
+	}{
+		{
+			description: "complete bipartite K3,3",
+			input: edgeListGraph(false,
+				[2]int{1, 4}, [2]int{1, 5}, [2]int{1, 6},
+				[2]int{2, 4}, [2]int{2, 5}, [2]int{2, 6},
+				[2]int{3, 4}, [2]int{3, 5}, [2]int{3, 6},
+			),
+			wantMax: 3,
+		},
+		{
+			description: "even cycle",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 1}),
+			wantMax:     2,
+		},
+		{
+			description: "odd cycle",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{5, 1}),
+			wantMax:     3,
+		},
+		{
+			description: "petersen graph",
+			input: edgeListGraph(false,
+				[2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 0},
+				[2]int{0, 5}, [2]int{1, 6}, [2]int{2, 7}, [2]int{3, 8}, [2]int{4, 9},
+				[2]int{5, 7}, [2]int{7, 9}, [2]int{9, 6}, [2]int{6, 8}, [2]int{8, 5},
+			),
+			wantMax: 4,
+		},
+		{
+			description: "self-loop ignored",
+			input:       edgeListGraph(false, [2]int{1, 1}, [2]int{1, 2}),
+			wantMax:     1,
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.EdgeColoring()
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !isProperEdgeColoring(test.input, got) {
+					t.Errorf("improper edge coloring: %v", got)
+				}
+				for e, c := range got {
+					if c >= test.wantMax {
+						t.Errorf("edge %v: color %v >= %v", e, c, test.wantMax)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestEdgeColoringRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		g := NewGraph[int](false)
+		bipartite := i%2 == 0
+		for j := 0; j < 120; j++ {
+			a, b := r.Intn(20), r.Intn(20)
+			if bipartite {
+				a, b = 2*a, 2*b+1
+			}
+			if a != b {
+				_ = g.AddEdge(a, b, 1)
+			}
+		}
+
+		got, err := g.EdgeColoring()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isProperEdgeColoring(g, got) {
+			t.Fatalf("graph %v: improper edge coloring: %v", g, got)
+		}
+
+		limit := maxDegree(g) + 1
+		if bipartite {
+			limit = maxDegree(g)
+		}
+		for e, c := range got {
+			if c >= limit {
+				t.Fatalf("graph %v: edge %v: color %v >= %v", g, e, c, limit)
+			}
+		}
+	}
+}
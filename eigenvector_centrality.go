@@ -0,0 +1,210 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// EigenvectorCentrality computes the eigenvector centrality of every vertex by
+// power iteration: a vertex is central if it is linked to by other central
+// vertices. The scores are the entries of the principal eigenvector of the
+// transposed adjacency matrix, scaled to unit Euclidean length. To converge on
+// bipartite and periodic graphs, the iteration uses A+I, which has the same
+// eigenvectors. In a directed graph, a vertex is scored by its inbound edges.
+// Edge weights are ignored. Iteration stops when the L1 change between
+// successive iterations is at most n*tol. If tol is negative or maxIter is
+// less than 1, it returns InvalidArgumentErr. If the scores do not converge
+// within maxIter iterations, it returns ConvergenceErr.
+func (g *Graph[V]) EigenvectorCentrality(tol float64, maxIter int) (map[V]float64, error) {
+	if !(tol >= 0) {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", tol), "tol must be non-negative"}
+	}
+	if maxIter < 1 {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", maxIter), "maxIter must be at least 1"}
+	}
+
+	n := len(g.vertices)
+	if n == 0 {
+		return map[V]float64{}, nil
+	}
+
+	x := make(map[V]float64, n)
+	for v := range g.vertices {
+		x[v] = 1 / float64(n)
+	}
+
+	for i := 0; i < maxIter; i++ {
+		next := make(map[V]float64, n)
+		for v := range g.vertices {
+			next[v] = x[v]
+		}
+		for u, edges := range g.adjacencyMap {
+			for v := range edges.Explicit {
+				next[v] += x[u]
+			}
+		}
+		normalizeL2(next)
+
+		if l1Distance(next, x) <= float64(n)*tol {
+			return next, nil
+		}
+		x = next
+	}
+
+	return nil, ConvergenceErr{maxIter}
+}
+
+// KatzCentrality computes the Katz centrality of every vertex by iterating
+// x = alpha*Aᵀx + beta, so that a vertex scores beta plus alpha times the
+// scores of the vertices linking to it. Paths of length k contribute with
+// weight alpha^k, and the iteration converges only if alpha is smaller than
+// the reciprocal of the largest eigenvalue of the adjacency matrix. The
+// result is scaled to unit Euclidean length. In a directed graph, a vertex is
+// scored by its inbound edges. Edge weights are ignored. Iteration stops when
+// the L1 change between successive iterations is at most n*tol. If alpha or
+// tol is negative or maxIter is less than 1, it returns InvalidArgumentErr. If
+// the scores do not converge within maxIter iterations, it returns
+// ConvergenceErr.
+func (g *Graph[V]) KatzCentrality(alpha, beta, tol float64, maxIter int) (map[V]float64, error) {
+	if alpha < 0 {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", alpha), "alpha must be non-negative"}
+	}
+	if !(tol >= 0) {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", tol), "tol must be non-negative"}
+	}
+	if maxIter < 1 {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", maxIter), "maxIter must be at least 1"}
+	}
+
+	n := len(g.vertices)
+	if n == 0 {
+		return map[V]float64{}, nil
+	}
+
+	x := make(map[V]float64, n)
+	for i := 0; i < maxIter; i++ {
+		next := make(map[V]float64, n)
+		for v := range g.vertices {
+			next[v] = beta
+		}
+		for u, edges := range g.adjacencyMap {
+			for v := range edges.Explicit {
+				next[v] += alpha * x[u]
+			}
+		}
+
+		if l1Distance(next, x) <= float64(n)*tol {
+			normalizeL2(next)
+			return next, nil
+		}
+		x = next
+	}
+
+	return nil, ConvergenceErr{maxIter}
+}
+
+// HITS computes the hub and authority scores of every vertex using Kleinberg's
+// Hyperlink-Induced Topic Search. A good authority is linked to by good hubs,
+// and a good hub links to good authorities: authorities are iterated as Aᵀh
+// and hubs as Aa. Each set of scores sums to 1. In an undirected graph the hub
+// and authority scores are equal. Edge weights are ignored. Iteration stops
+// when the L1 change in the hub scores between successive iterations is at
+// most n*tol. If tol is negative or maxIter is less than 1, it returns
+// InvalidArgumentErr. If the scores do not converge within maxIter
+// iterations, it returns ConvergenceErr.
+func (g *Graph[V]) HITS(tol float64, maxIter int) (hubs, authorities map[V]float64, err error) {
+	if !(tol >= 0) {
+		return nil, nil, InvalidArgumentErr{fmt.Sprintf("%v", tol), "tol must be non-negative"}
+	}
+	if maxIter < 1 {
+		return nil, nil, InvalidArgumentErr{fmt.Sprintf("%v", maxIter), "maxIter must be at least 1"}
+	}
+
+	n := len(g.vertices)
+	if n == 0 {
+		return map[V]float64{}, map[V]float64{}, nil
+	}
+
+	hubs = make(map[V]float64, n)
+	for v := range g.vertices {
+		hubs[v] = 1 / float64(n)
+	}
+
+	for i := 0; i < maxIter; i++ {
+		authorities = make(map[V]float64, n)
+		for v := range g.vertices {
+			authorities[v] = 0
+		}
+		for u, edges := range g.adjacencyMap {
+			for v := range edges.Explicit {
+				authorities[v] += hubs[u]
+			}
+		}
+		normalizeMax(authorities)
+
+		next := make(map[V]float64, n)
+		for u, edges := range g.adjacencyMap {
+			next[u] = 0
+			for v := range edges.Explicit {
+				next[u] += authorities[v]
+			}
+		}
+		normalizeMax(next)
+
+		converged := l1Distance(next, hubs) <= float64(n)*tol
+		hubs = next
+		if converged {
+			normalizeSum(hubs)
+			normalizeSum(authorities)
+			return hubs, authorities, nil
+		}
+	}
+
+	return nil, nil, ConvergenceErr{maxIter}
+}
+
+// l1Distance returns the sum of absolute differences between x and y over the
+// keys of x.
+func l1Distance[V comparable](x, y map[V]float64) float64 {
+	distance := 0.0
+	for v, value := range x {
+		distance += math.Abs(value - y[v])
+	}
+	return distance
+}
+
+// normalizeL2 scales x to unit Euclidean length, unless it is all zero.
+func normalizeL2[V comparable](x map[V]float64) {
+	norm := 0.0
+	for _, value := range x {
+		norm += value * value
+	}
+	scaleVector(x, math.Sqrt(norm))
+}
+
+// normalizeMax scales x so that its largest value is 1, unless it is all zero.
+func normalizeMax[V comparable](x map[V]float64) {
+	max := 0.0
+	for _, value := range x {
+		max = math.Max(max, value)
+	}
+	scaleVector(x, max)
+}
+
+// normalizeSum scales x so that its values sum to 1, unless they sum to zero.
+func normalizeSum[V comparable](x map[V]float64) {
+	sum := 0.0
+	for _, value := range x {
+		sum += value
+	}
+	scaleVector(x, sum)
+}
+
+func scaleVector[V comparable](x map[V]float64, divisor float64) {
+	if divisor == 0 {
+		return
+	}
+	for v := range x {
+		x[v] /= divisor
+	}
+}
@@ -0,0 +1,232 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestEigenvectorCentrality(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[string]
+		tol         float64
+		maxIter     int
+		want        map[string]float64
+		wantError   error
+	}{
+		{
+			description: "undirected star",
+			input:       edgeListGraph(false, [2]string{"c", "a"}, [2]string{"c", "b"}),
+			tol:         1e-9,
+			maxIter:     1000,
+			want:        map[string]float64{"a": 0.5, "b": 0.5, "c": 1 / math.Sqrt2},
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}),
+			tol:         1e-9,
+			maxIter:     1000,
+			want:        map[string]float64{"a": 1 / math.Sqrt(3), "b": 1 / math.Sqrt(3), "c": 1 / math.Sqrt(3)},
+		},
+		{
+			description: "empty graph",
+			input:       NewGraph[string](true),
+			tol:         1e-9,
+			maxIter:     1000,
+			want:        map[string]float64{},
+		},
+		{
+			description: "zero tol",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}),
+			tol:         0,
+			maxIter:     1000,
+			want:        map[string]float64{"a": 1 / math.Sqrt(3), "b": 1 / math.Sqrt(3), "c": 1 / math.Sqrt(3)},
+		},
+		{
+			description: "negative tol",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			tol:         -1,
+			maxIter:     1000,
+			wantError:   InvalidArgumentErr{"-1", "tol must be non-negative"},
+		},
+		{
+			description: "invalid maxIter",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			tol:         1e-9,
+			maxIter:     0,
+			wantError:   InvalidArgumentErr{"0", "maxIter must be at least 1"},
+		},
+		{
+			description: "not converged",
+			input:       edgeListGraph(false, [2]string{"c", "a"}, [2]string{"c", "b"}),
+			tol:         1e-9,
+			maxIter:     2,
+			wantError:   ConvergenceErr{2},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.EigenvectorCentrality(test.tol, test.maxIter)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-6)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestKatzCentrality(t *testing.T) {
+	norm := math.Sqrt(1 + 1.1*1.1 + 1.11*1.11)
+
+	tests := []struct {
+		description string
+		input       Graph[string]
+		alpha       float64
+		tol         float64
+		want        map[string]float64
+		wantError   error
+	}{
+		{
+			description: "directed path",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "c"}),
+			alpha:       0.1,
+			tol:         1e-9,
+			want:        map[string]float64{"a": 1 / norm, "b": 1.1 / norm, "c": 1.11 / norm},
+		},
+		{
+			description: "alpha too large",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "a"}),
+			alpha:       2,
+			tol:         1e-9,
+			wantError:   ConvergenceErr{1000},
+		},
+		{
+			description: "zero tol",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "c"}),
+			alpha:       0.1,
+			tol:         0,
+			want:        map[string]float64{"a": 1 / norm, "b": 1.1 / norm, "c": 1.11 / norm},
+		},
+		{
+			description: "negative tol",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			alpha:       0.1,
+			tol:         -1,
+			wantError:   InvalidArgumentErr{"-1", "tol must be non-negative"},
+		},
+		{
+			description: "negative alpha",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			alpha:       -1,
+			tol:         1e-9,
+			wantError:   InvalidArgumentErr{"-1", "alpha must be non-negative"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.KatzCentrality(test.alpha, 1, test.tol, 1000)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-6)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestHITS(t *testing.T) {
+	phi := (1 + math.Sqrt(5)) / 2
+
+	tests := []struct {
+		description     string
+		input           Graph[string]
+		wantHubs        map[string]float64
+		wantAuthorities map[string]float64
+		tol             float64
+		maxIter         int
+		wantError       error
+	}{
+		{
+			description:     "two hubs sharing an authority",
+			input:           edgeListGraph(true, [2]string{"a", "b"}, [2]string{"a", "c"}, [2]string{"d", "b"}),
+			wantHubs:        map[string]float64{"a": 1 / phi, "b": 0, "c": 0, "d": 1 - 1/phi},
+			wantAuthorities: map[string]float64{"a": 0, "b": 1 / phi, "c": 1 - 1/phi, "d": 0},
+			tol:             1e-9,
+			maxIter:         1000,
+		},
+		{
+			description:     "undirected triangle",
+			input:           edgeListGraph(false, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}),
+			wantHubs:        map[string]float64{"a": 1.0 / 3.0, "b": 1.0 / 3.0, "c": 1.0 / 3.0},
+			wantAuthorities: map[string]float64{"a": 1.0 / 3.0, "b": 1.0 / 3.0, "c": 1.0 / 3.0},
+			tol:             1e-9,
+			maxIter:         1000,
+		},
+		{
+			description:     "zero tol",
+			input:           edgeListGraph(false, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}),
+			wantHubs:        map[string]float64{"a": 1.0 / 3.0, "b": 1.0 / 3.0, "c": 1.0 / 3.0},
+			wantAuthorities: map[string]float64{"a": 1.0 / 3.0, "b": 1.0 / 3.0, "c": 1.0 / 3.0},
+			tol:             0,
+			maxIter:         1000,
+		},
+		{
+			description: "negative tol",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			tol:         -1,
+			maxIter:     1000,
+			wantError:   InvalidArgumentErr{"-1", "tol must be non-negative"},
+		},
+		{
+			description: "invalid maxIter",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			tol:         1e-9,
+			maxIter:     -1,
+			wantError:   InvalidArgumentErr{"-1", "maxIter must be at least 1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			hubs, authorities, err := test.input.HITS(test.tol, test.maxIter)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(hubs, test.wantHubs, cmpopts.EquateApprox(0, 1e-6)) {
+					t.Errorf("%v != %v", hubs, test.wantHubs)
+				}
+				if !cmp.Equal(authorities, test.wantAuthorities, cmpopts.EquateApprox(0, 1e-6)) {
+					t.Errorf("%v != %v", authorities, test.wantAuthorities)
+				}
+			}
+		})
+	}
+}
@@ -1,5 +1,7 @@
 package graph
 
+import "strconv"
+
 // InvalidArgumentErr describes argument parameters that a receiving method
 // considers invalid or incorrectly specified.
 type InvalidArgumentErr struct {
@@ -17,3 +19,21 @@ type DirectedGraphErr struct{}
 func (e DirectedGraphErr) Error() string {
 	return "err: operation not supported on directed graphs"
 }
+
+// ConvergenceErr describes an iterative computation that did not converge
+// within the allowed number of iterations.
+type ConvergenceErr struct {
+	iterations int
+}
+
+func (e ConvergenceErr) Error() string {
+	return "err: failed to converge in " + strconv.Itoa(e.iterations) + " iterations"
+}
+
+// DisconnectedGraphErr describes an operation that requires every vertex of
+// a graph to be reachable from every other vertex.
+type DisconnectedGraphErr struct{}
+
+func (e DisconnectedGraphErr) Error() string {
+	return "err: graph is disconnected"
+}
@@ -79,6 +79,13 @@ func (e *DuplicateEdgeErr[V]) Is(target error) bool {
 	return reflect.TypeOf(e) == reflect.TypeOf(target)
 }
 
+// An Edge is an ordered pair of vertices. In an undirected graph, the order of
+// From and To carries no meaning; see GetAllEdges for how undirected edges are
+// oriented.
+type Edge[V comparable] struct {
+	From, To V
+}
+
 // A Graph is an unordered set of nodes along with a set of weighted ordered-pair
 // relationships between nodes.
 type Graph[V comparable] struct {
@@ -265,6 +272,11 @@ func (g *Graph[V]) removeImplicitEdge(a, b V) error {
 	return nil
 }
 
+// IsDirected returns true if the graph enforces directed edges.
+func (g Graph[V]) IsDirected() bool {
+	return g.isDirected
+}
+
 // NumVertex returns the number of vertices in the graph.
 func (g Graph[V]) NumVertex() int {
 	return len(g.vertices)
@@ -382,6 +394,16 @@ func (g Graph[V]) GetAllEdges() []struct {
 	return edges
 }
 
+// edgeKey returns the Edge from a to b. In an undirected graph, the endpoints
+// are ordered the same way GetAllEdges orders them, so that both directions of
+// an edge map to the same key.
+func (g Graph[V]) edgeKey(a, b V) Edge[V] {
+	if !g.isDirected && fmt.Sprintf("%v", a) > fmt.Sprintf("%v", b) {
+		return Edge[V]{From: b, To: a}
+	}
+	return Edge[V]{From: a, To: b}
+}
+
 // NumEdges returns the number of edges in the graph.
 func (g Graph[V]) NumEdges() int {
 	count := 0
@@ -0,0 +1,110 @@
+package graph
+
+import (
+	"math"
+	"runtime"
+	"sync"
+)
+
+// A JohnsonOption configures Johnson.
+type JohnsonOption func(*johnsonConfig)
+
+type johnsonConfig struct {
+	workers int
+}
+
+// JohnsonWorkers sets the number of goroutines that run Dijkstra's algorithm
+// from different source vertices concurrently. The default is 1, which runs
+// the searches one after another; if n is less than 1, runtime.GOMAXPROCS(0)
+// is used.
+func JohnsonWorkers(n int) JohnsonOption {
+	return func(c *johnsonConfig) {
+		c.workers = n
+	}
+}
+
+// Johnson computes the shortest paths between all pairs of vertices using
+// Johnson's algorithm. One Bellman-Ford pass, started from every vertex at
+// distance 0 as if from an extra vertex joined to all of them, finds a
+// potential h for each vertex. Reweighting every edge (u, v) to
+// weight + h(u) - h(v) makes all weights non-negative without changing which
+// paths are shortest, so Dijkstra's algorithm can then run from every vertex.
+// This takes O(VE log V) time instead of the O(V³) of FloydWarshall, which is
+// much less on sparse graphs, and it handles negative edge weights as well.
+// The result has the same shape as that of FloydWarshall: unreachable pairs
+// have a Distance of math.Inf(1) and a nil Path. If the graph has a negative
+// cycle, it returns NegativeCycleErr.
+func (g *Graph[V]) Johnson(opts ...JohnsonOption) (map[V]map[V]PathResult[V], error) {
+	config := johnsonConfig{workers: 1}
+	for _, opt := range opts {
+		opt(&config)
+	}
+	if config.workers < 1 {
+		config.workers = runtime.GOMAXPROCS(0)
+	}
+
+	potential := make(map[V]float64, len(g.vertices))
+	for v := range g.vertices {
+		potential[v] = 0
+	}
+	if err := g.relaxEdges(potential, make(map[V]*V)); err != nil {
+		return nil, err
+	}
+
+	reweighted := g
+	for _, h := range potential {
+		if h != 0 {
+			reweighted = g.reweight(potential)
+			break
+		}
+	}
+
+	results := make(map[V]map[V]PathResult[V], len(g.vertices))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	queue := make(chan V)
+	for i := 0; i < config.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for s := range queue {
+				tree := reweighted.dijkstraSearch(s, nil, nil, nil)
+				paths := make(map[V]PathResult[V], len(g.vertices))
+				for v := range g.vertices {
+					r := tree.PathResult(v)
+					if tree.Reached(v) {
+						r.Distance += potential[v] - potential[s]
+					}
+					paths[v] = r
+				}
+				mu.Lock()
+				results[s] = paths
+				mu.Unlock()
+			}
+		}()
+	}
+	for s := range g.vertices {
+		queue <- s
+	}
+	close(queue)
+	wg.Wait()
+
+	return results, nil
+}
+
+// reweight returns a directed copy of the graph in which every edge (u, v)
+// has weight + potential[u] - potential[v]. Rounding errors that would make a
+// weight slightly negative are clamped to 0.
+func (g *Graph[V]) reweight(potential map[V]float64) *Graph[V] {
+	r := NewGraph[V](true)
+	for v := range g.vertices {
+		_ = r.AddVertex(v)
+	}
+	for u, edges := range g.adjacencyMap {
+		for v, weight := range edges.Explicit {
+			_ = r.AddEdge(u, v, math.Max(0, weight+potential[u]-potential[v]))
+		}
+	}
+
+	return &r
+}
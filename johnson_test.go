@@ -0,0 +1,180 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestJohnson(t *testing.T) {
+	negative := NewGraph[int](true)
+	_ = negative.AddEdge(1, 2, 4)
+	_ = negative.AddEdge(1, 3, 1)
+	_ = negative.AddEdge(3, 2, -2)
+	_ = negative.AddEdge(2, 4, 1)
+
+	undirected := NewGraph[int](false)
+	_ = undirected.AddEdge(1, 2, 1)
+	_ = undirected.AddEdge(2, 3, 2)
+	_ = undirected.AddEdge(1, 3, 4)
+	_ = undirected.AddVertex(4)
+
+	negativeCycle := NewGraph[int](true)
+	_ = negativeCycle.AddEdge(1, 2, 1)
+	_ = negativeCycle.AddEdge(2, 3, -2)
+	_ = negativeCycle.AddEdge(3, 1, -1)
+
+	negativeUndirected := NewGraph[int](false)
+	_ = negativeUndirected.AddEdge(1, 2, -1)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        map[int]map[int]PathResult[int]
+		wantError   error
+	}{
+		{
+			description: "negative weights",
+			input:       negative,
+			want: map[int]map[int]PathResult[int]{
+				1: {
+					1: {Distance: 0, Path: []int{1}},
+					2: {Distance: -1, Path: []int{1, 3, 2}},
+					3: {Distance: 1, Path: []int{1, 3}},
+					4: {Distance: 0, Path: []int{1, 3, 2, 4}},
+				},
+				2: {
+					1: {Distance: math.Inf(1)},
+					2: {Distance: 0, Path: []int{2}},
+					3: {Distance: math.Inf(1)},
+					4: {Distance: 1, Path: []int{2, 4}},
+				},
+				3: {
+					1: {Distance: math.Inf(1)},
+					2: {Distance: -2, Path: []int{3, 2}},
+					3: {Distance: 0, Path: []int{3}},
+					4: {Distance: -1, Path: []int{3, 2, 4}},
+				},
+				4: {
+					1: {Distance: math.Inf(1)},
+					2: {Distance: math.Inf(1)},
+					3: {Distance: math.Inf(1)},
+					4: {Distance: 0, Path: []int{4}},
+				},
+			},
+		},
+		{
+			description: "undirected",
+			input:       undirected,
+			want: map[int]map[int]PathResult[int]{
+				1: {
+					1: {Distance: 0, Path: []int{1}},
+					2: {Distance: 1, Path: []int{1, 2}},
+					3: {Distance: 3, Path: []int{1, 2, 3}},
+					4: {Distance: math.Inf(1)},
+				},
+				2: {
+					1: {Distance: 1, Path: []int{2, 1}},
+					2: {Distance: 0, Path: []int{2}},
+					3: {Distance: 2, Path: []int{2, 3}},
+					4: {Distance: math.Inf(1)},
+				},
+				3: {
+					1: {Distance: 3, Path: []int{3, 2, 1}},
+					2: {Distance: 2, Path: []int{3, 2}},
+					3: {Distance: 0, Path: []int{3}},
+					4: {Distance: math.Inf(1)},
+				},
+				4: {
+					1: {Distance: math.Inf(1)},
+					2: {Distance: math.Inf(1)},
+					3: {Distance: math.Inf(1)},
+					4: {Distance: 0, Path: []int{4}},
+				},
+			},
+		},
+		{
+			description: "empty",
+			input:       NewGraph[int](true),
+			want:        map[int]map[int]PathResult[int]{},
+		},
+		{
+			description: "negative cycle",
+			input:       negativeCycle,
+			wantError:   &NegativeCycleErr[int]{},
+		},
+		{
+			description: "undirected negative edge",
+			input:       negativeUndirected,
+			wantError:   &NegativeCycleErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Johnson()
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestJohnsonRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		// Edges to higher-numbered vertices may be negative, and edges back
+		// are too heavy for any cycle to be negative.
+		g := NewGraph[int](true)
+		for v := 0; v < 15; v++ {
+			_ = g.AddVertex(v)
+		}
+		for u := 0; u < 15; u++ {
+			for v := 0; v < 15; v++ {
+				if u == v || r.Float64() >= 0.2 {
+					continue
+				}
+				if u < v {
+					_ = g.AddEdge(u, v, float64(r.Intn(13)-3))
+				} else {
+					_ = g.AddEdge(u, v, float64(50+r.Intn(9)))
+				}
+			}
+		}
+
+		want, err := g.FloydWarshall()
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := g.Johnson(JohnsonWorkers(0))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for u := range want {
+			for v := range want[u] {
+				if got[u][v].Distance != want[u][v].Distance {
+					t.Fatalf("%v: %v to %v: %v != %v", g, u, v, got[u][v].Distance, want[u][v].Distance)
+				}
+				if !math.IsInf(got[u][v].Distance, 1) {
+					if !isPath(g, got[u][v].Path, u, v) || g.pathWeight(got[u][v].Path) != got[u][v].Distance {
+						t.Errorf("%v: %v is not a path of weight %v from %v to %v", g, got[u][v].Path, got[u][v].Distance, u, v)
+					}
+				}
+			}
+		}
+	}
+}
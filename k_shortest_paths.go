@@ -0,0 +1,122 @@
+package graph
+
+import "fmt"
+
+// KShortestPaths returns up to k loopless paths from source to target in
+// order of increasing distance, using Yen's algorithm on top of Dijkstra. The
+// first path is a shortest path, and each following path is the shortest one
+// that differs from all paths before it. Paths of equal distance are ordered
+// by number of edges. If fewer than k loopless paths exist, all of them are
+// returned; if target is unreachable, the result is empty. Edge weights must
+// be non-negative. If the graph does not contain source or target, it returns
+// MissingVertexErr. If k is less than 1 or an edge has a negative weight, it
+// returns InvalidArgumentErr.
+func (g *Graph[V]) KShortestPaths(source, target V, k int) ([]PathResult[V], error) {
+	if _, ok := g.vertices[source]; !ok {
+		return nil, &MissingVertexErr[V]{v: source}
+	}
+	if _, ok := g.vertices[target]; !ok {
+		return nil, &MissingVertexErr[V]{v: target}
+	}
+	if k < 1 {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", k), "k must be at least 1"}
+	}
+	if weight, negative := g.negativeWeight(); negative {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", weight), "edge weights must be non-negative"}
+	}
+
+	paths := make([]PathResult[V], 0, k)
+	first := g.dijkstraSearch(source, &target, nil, nil).PathResult(target)
+	if first.Path == nil {
+		return paths, nil
+	}
+	paths = append(paths, first)
+
+	candidates := make([]PathResult[V], 0)
+	for len(paths) < k {
+		last := paths[len(paths)-1].Path
+
+		// Branch off the last path at every vertex but the target: keep the
+		// root up to that vertex (the spur vertex), forbid the edges that
+		// earlier paths with the same root take next, and forbid the root
+		// itself so that the new path stays loopless.
+		for i := 0; i < len(last)-1; i++ {
+			root := last[:i+1]
+
+			skipEdges := make(map[Edge[V]]bool)
+			for _, p := range paths {
+				if len(p.Path) > i+1 && equalPaths(p.Path[:i+1], root) {
+					skipEdges[g.edgeKey(p.Path[i], p.Path[i+1])] = true
+				}
+			}
+			skipVertices := make(set[V], i)
+			for _, v := range root[:i] {
+				skipVertices[v] = true
+			}
+
+			spur := g.dijkstraSearch(last[i], &target, skipVertices, skipEdges).PathResult(target)
+			if spur.Path == nil {
+				continue
+			}
+
+			path := make([]V, 0, i+len(spur.Path))
+			path = append(path, root[:i]...)
+			path = append(path, spur.Path...)
+			if containsPath(paths, path) || containsPath(candidates, path) {
+				continue
+			}
+			candidates = append(candidates, PathResult[V]{Distance: g.pathWeight(path), Path: path})
+		}
+
+		if len(candidates) == 0 {
+			break
+		}
+		best := 0
+		for i, c := range candidates {
+			if c.Distance < candidates[best].Distance ||
+				(c.Distance == candidates[best].Distance && len(c.Path) < len(candidates[best].Path)) {
+				best = i
+			}
+		}
+		paths = append(paths, candidates[best])
+		candidates = append(candidates[:best], candidates[best+1:]...)
+	}
+
+	return paths, nil
+}
+
+// pathWeight returns the total weight of the edges along path.
+func (g *Graph[V]) pathWeight(path []V) float64 {
+	total := 0.0
+	for i := 1; i < len(path); i++ {
+		total += g.adjacencyMap[path[i-1]].Explicit[path[i]]
+	}
+
+	return total
+}
+
+// containsPath returns true if one of results follows path.
+func containsPath[V comparable](results []PathResult[V], path []V) bool {
+	for _, r := range results {
+		if equalPaths(r.Path, path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// equalPaths returns true if a and b visit the same vertices in the same
+// order.
+func equalPaths[V comparable](a, b []V) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,168 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// yenGraph returns the directed example graph from Yen's algorithm's usual
+// walkthrough.
+func yenGraph() Graph[string] {
+	g := NewGraph[string](true)
+	_ = g.AddEdge("C", "D", 3)
+	_ = g.AddEdge("C", "E", 2)
+	_ = g.AddEdge("D", "F", 4)
+	_ = g.AddEdge("E", "D", 1)
+	_ = g.AddEdge("E", "F", 2)
+	_ = g.AddEdge("E", "G", 3)
+	_ = g.AddEdge("F", "G", 2)
+	_ = g.AddEdge("F", "H", 1)
+	_ = g.AddEdge("G", "H", 2)
+	return g
+}
+
+func TestKShortestPaths(t *testing.T) {
+	square := NewGraph[string](false)
+	_ = square.AddEdge("a", "b", 1)
+	_ = square.AddEdge("b", "d", 1)
+	_ = square.AddEdge("a", "c", 2)
+	_ = square.AddEdge("c", "d", 2)
+
+	negative := NewGraph[string](true)
+	_ = negative.AddEdge("a", "b", -1)
+
+	tests := []struct {
+		description string
+		input       Graph[string]
+		source      string
+		target      string
+		k           int
+		want        []PathResult[string]
+		wantError   error
+	}{
+		{
+			description: "three best",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "H",
+			k:           3,
+			want: []PathResult[string]{
+				{Distance: 5, Path: []string{"C", "E", "F", "H"}},
+				{Distance: 7, Path: []string{"C", "E", "G", "H"}},
+				{Distance: 8, Path: []string{"C", "D", "F", "H"}},
+			},
+		},
+		{
+			description: "undirected",
+			input:       square,
+			source:      "a",
+			target:      "d",
+			k:           3,
+			want: []PathResult[string]{
+				{Distance: 2, Path: []string{"a", "b", "d"}},
+				{Distance: 4, Path: []string{"a", "c", "d"}},
+			},
+		},
+		{
+			description: "source is target",
+			input:       square,
+			source:      "a",
+			target:      "a",
+			k:           2,
+			want: []PathResult[string]{
+				{Distance: 0, Path: []string{"a"}},
+			},
+		},
+		{
+			description: "unreachable",
+			input:       yenGraph(),
+			source:      "H",
+			target:      "C",
+			k:           2,
+			want:        []PathResult[string]{},
+		},
+		{
+			description: "invalid k",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "H",
+			k:           0,
+			wantError:   InvalidArgumentErr{"0", "k must be at least 1"},
+		},
+		{
+			description: "missing vertex",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "Z",
+			k:           1,
+			wantError:   &MissingVertexErr[string]{},
+		},
+		{
+			description: "negative weight",
+			input:       negative,
+			source:      "a",
+			target:      "b",
+			k:           1,
+			wantError:   InvalidArgumentErr{"-1", "edge weights must be non-negative"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.KShortestPaths(test.source, test.target, test.k)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestKShortestPathsExhaustive(t *testing.T) {
+	g := yenGraph()
+
+	got, err := g.KShortestPaths("C", "H", 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// There are seven loopless paths from C to H.
+	distances := make([]float64, 0, len(got))
+	for i, p := range got {
+		distances = append(distances, p.Distance)
+		if p.Path[0] != "C" || p.Path[len(p.Path)-1] != "H" {
+			t.Errorf("path %v does not lead from C to H", p.Path)
+		}
+		if p.Distance != g.pathWeight(p.Path) {
+			t.Errorf("%v != %v", p.Distance, g.pathWeight(p.Path))
+		}
+		for _, q := range got[:i] {
+			if equalPaths(p.Path, q.Path) {
+				t.Errorf("path %v returned twice", p.Path)
+			}
+		}
+		seen := make(map[string]bool)
+		for _, v := range p.Path {
+			if seen[v] {
+				t.Errorf("path %v is not loopless", p.Path)
+			}
+			seen[v] = true
+		}
+	}
+
+	want := []float64{5, 7, 8, 8, 8, 11, 11}
+	if !cmp.Equal(distances, want) {
+		t.Errorf("%v != %v", distances, want)
+	}
+}
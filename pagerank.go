@@ -0,0 +1,165 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+)
+
+// A PageRankOption configures PageRank and PersonalizedPageRank.
+type PageRankOption func(*pageRankConfig)
+
+type pageRankConfig struct {
+	weighted bool
+}
+
+// PageRankWeighted makes the random surfer follow each outbound edge with
+// probability proportional to its weight, instead of choosing uniformly among
+// the outbound edges. Edge weights must be non-negative.
+func PageRankWeighted() PageRankOption {
+	return func(c *pageRankConfig) {
+		c.weighted = true
+	}
+}
+
+// PageRank computes the PageRank of every vertex by power iteration. A random
+// surfer follows an outbound edge with probability damping and otherwise jumps
+// to a vertex chosen uniformly at random; a surfer at a vertex without
+// outbound edges (a dangling vertex) always jumps. The scores sum to 1.
+// Iteration stops when the L1 change between successive iterations is at
+// most n*tol, so a tol of 0 asks for an exact fixed point. In an undirected
+// graph, every edge can be followed both ways. If damping is not in [0, 1],
+// tol is negative or maxIter is less than 1, it returns InvalidArgumentErr. If
+// the scores do not converge within maxIter iterations, it returns
+// ConvergenceErr.
+func (g *Graph[V]) PageRank(damping, tol float64, maxIter int, opts ...PageRankOption) (map[V]float64, error) {
+	uniform := make(map[V]float64, len(g.vertices))
+	for v := range g.vertices {
+		uniform[v] = 1
+	}
+
+	return g.PersonalizedPageRank(uniform, damping, tol, maxIter, opts...)
+}
+
+// PersonalizedPageRank computes PageRank with the random jumps, including
+// those from dangling vertices, distributed according to personalization
+// instead of uniformly. The personalization values are normalized to sum to
+// 1; vertices missing from it receive no jumps. Giving a single vertex all
+// the weight computes a random walk with restart from that vertex. If
+// personalization refers to a vertex that is not in the graph, it returns
+// MissingVertexErr. If its values are negative or sum to 0, or the other
+// arguments are invalid as described for PageRank, it returns
+// InvalidArgumentErr.
+func (g *Graph[V]) PersonalizedPageRank(personalization map[V]float64, damping, tol float64, maxIter int, opts ...PageRankOption) (map[V]float64, error) {
+	config := pageRankConfig{}
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	if !(damping >= 0 && damping <= 1) {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", damping), "damping must be between 0 and 1"}
+	}
+	if !(tol >= 0) {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", tol), "tol must be non-negative"}
+	}
+	if maxIter < 1 {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", maxIter), "maxIter must be at least 1"}
+	}
+
+	n := len(g.vertices)
+	if n == 0 {
+		return map[V]float64{}, nil
+	}
+
+	jump, err := g.normalizedVector(personalization)
+	if err != nil {
+		return nil, err
+	}
+
+	// Precompute the probability of following each outbound edge.
+	transitions := make(map[V]map[V]float64, n)
+	for u := range g.vertices {
+		total := 0.0
+		for _, weight := range g.adjacencyMap[u].Explicit {
+			if config.weighted {
+				if weight < 0 {
+					return nil, InvalidArgumentErr{fmt.Sprintf("%v", weight), "edge weights must be non-negative"}
+				}
+				total += weight
+			} else {
+				total++
+			}
+		}
+		if total == 0 {
+			continue
+		}
+		transitions[u] = make(map[V]float64, len(g.adjacencyMap[u].Explicit))
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			if config.weighted {
+				transitions[u][v] = weight / total
+			} else {
+				transitions[u][v] = 1 / total
+			}
+		}
+	}
+
+	rank := make(map[V]float64, n)
+	for v := range g.vertices {
+		rank[v] = 1 / float64(n)
+	}
+
+	for i := 0; i < maxIter; i++ {
+		dangling := 0.0
+		for u := range g.vertices {
+			if _, ok := transitions[u]; !ok {
+				dangling += rank[u]
+			}
+		}
+
+		next := make(map[V]float64, n)
+		for v := range g.vertices {
+			next[v] = (damping*dangling + 1 - damping) * jump[v]
+		}
+		for u, edges := range transitions {
+			for v, p := range edges {
+				next[v] += damping * rank[u] * p
+			}
+		}
+
+		delta := 0.0
+		for v := range g.vertices {
+			delta += math.Abs(next[v] - rank[v])
+		}
+		rank = next
+		if delta <= float64(n)*tol {
+			return rank, nil
+		}
+	}
+
+	return nil, ConvergenceErr{maxIter}
+}
+
+// normalizedVector scales the values of vector to sum to 1. If vector refers
+// to a vertex that is not in the graph, it returns MissingVertexErr. If a
+// value is negative or the values sum to 0, it returns InvalidArgumentErr.
+func (g *Graph[V]) normalizedVector(vector map[V]float64) (map[V]float64, error) {
+	total := 0.0
+	for v, value := range vector {
+		if _, ok := g.vertices[v]; !ok {
+			return nil, &MissingVertexErr[V]{v}
+		}
+		if value < 0 {
+			return nil, InvalidArgumentErr{fmt.Sprintf("%v", value), "values must be non-negative"}
+		}
+		total += value
+	}
+	if total == 0 {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", vector), "values must not sum to 0"}
+	}
+
+	normalized := make(map[V]float64, len(vector))
+	for v, value := range vector {
+		normalized[v] = value / total
+	}
+
+	return normalized, nil
+}
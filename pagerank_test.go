@@ -0,0 +1,183 @@
+package graph
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestPageRank(t *testing.T) {
+	weighted := NewGraph[string](true)
+	_ = weighted.AddEdge("a", "b", 3)
+	_ = weighted.AddEdge("a", "c", 1)
+	_ = weighted.AddEdge("b", "a", 1)
+	_ = weighted.AddEdge("c", "a", 1)
+
+	tests := []struct {
+		description string
+		input       Graph[string]
+		damping     float64
+		tol         float64
+		maxIter     int
+		options     []PageRankOption
+		want        map[string]float64
+		wantError   error
+	}{
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "c"}, [2]string{"c", "a"}),
+			damping:     0.85,
+			tol:         1e-9,
+			maxIter:     1000,
+			want:        map[string]float64{"a": 1.0 / 3.0, "b": 1.0 / 3.0, "c": 1.0 / 3.0},
+		},
+		{
+			description: "dangling vertex",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			damping:     0.85,
+			tol:         1e-9,
+			maxIter:     1000,
+			want:        map[string]float64{"a": 1 / 2.85, "b": 1.85 / 2.85},
+		},
+		{
+			description: "weighted transitions",
+			input:       weighted,
+			damping:     0.85,
+			tol:         1e-9,
+			maxIter:     1000,
+			options:     []PageRankOption{PageRankWeighted()},
+			want:        map[string]float64{"a": 2.7 / 5.55, "b": 0.05 + 0.85*0.75*2.7/5.55, "c": 0.05 + 0.85*0.25*2.7/5.55},
+		},
+		{
+			description: "undirected star",
+			input:       edgeListGraph(false, [2]string{"c", "a"}, [2]string{"c", "b"}),
+			damping:     0,
+			tol:         1e-9,
+			maxIter:     1000,
+			want:        map[string]float64{"a": 1.0 / 3.0, "b": 1.0 / 3.0, "c": 1.0 / 3.0},
+		},
+		{
+			description: "invalid damping",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			damping:     1.5,
+			tol:         1e-9,
+			maxIter:     1000,
+			wantError:   InvalidArgumentErr{"1.5", "damping must be between 0 and 1"},
+		},
+		{
+			description: "NaN damping",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			damping:     math.NaN(),
+			tol:         1e-9,
+			maxIter:     1000,
+			wantError:   InvalidArgumentErr{"NaN", "damping must be between 0 and 1"},
+		},
+		{
+			description: "zero tol",
+			input:       edgeListGraph(true, [2]string{"a", "b"}, [2]string{"b", "a"}),
+			damping:     0.85,
+			tol:         0,
+			maxIter:     100,
+			want:        map[string]float64{"a": 0.5, "b": 0.5},
+		},
+		{
+			description: "negative tol",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			damping:     0.85,
+			tol:         -1,
+			maxIter:     1000,
+			wantError:   InvalidArgumentErr{"-1", "tol must be non-negative"},
+		},
+		{
+			description: "invalid maxIter",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			damping:     0.85,
+			tol:         1e-9,
+			maxIter:     0,
+			wantError:   InvalidArgumentErr{"0", "maxIter must be at least 1"},
+		},
+		{
+			description: "not converged",
+			input:       edgeListGraph(true, [2]string{"a", "b"}),
+			damping:     0.85,
+			tol:         1e-9,
+			maxIter:     1,
+			wantError:   ConvergenceErr{1},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.PageRank(test.damping, test.tol, test.maxIter, test.options...)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-6)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPersonalizedPageRank(t *testing.T) {
+	tests := []struct {
+		description     string
+		input           Graph[string]
+		personalization map[string]float64
+		want            map[string]float64
+		wantError       error
+	}{
+		{
+			description:     "random walk with restart",
+			input:           edgeListGraph(true, [2]string{"a", "b"}),
+			personalization: map[string]float64{"a": 1},
+			want:            map[string]float64{"a": 1 / 1.85, "b": 0.85 / 1.85},
+		},
+		{
+			description:     "unnormalized personalization",
+			input:           edgeListGraph(true, [2]string{"a", "b"}),
+			personalization: map[string]float64{"a": 5},
+			want:            map[string]float64{"a": 1 / 1.85, "b": 0.85 / 1.85},
+		},
+		{
+			description:     "missing vertex",
+			input:           edgeListGraph(true, [2]string{"a", "b"}),
+			personalization: map[string]float64{"z": 1},
+			wantError:       &MissingVertexErr[string]{},
+		},
+		{
+			description:     "negative value",
+			input:           edgeListGraph(true, [2]string{"a", "b"}),
+			personalization: map[string]float64{"a": -1},
+			wantError:       InvalidArgumentErr{"-1", "values must be non-negative"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.PersonalizedPageRank(test.personalization, 0.85, 1e-9, 1000)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-6)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
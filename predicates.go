@@ -0,0 +1,370 @@
+package graph
+
+import "sort"
+
+// IsForest returns true if the graph has no cycles when edge direction is
+// ignored. Otherwise it also returns one such cycle as a list of distinct
+// vertices, each joined to the next and the last to the first. A self-loop is
+// a cycle of one vertex, and in a directed graph a pair of opposite edges is
+// a cycle of two.
+func (g *Graph[V]) IsForest() (bool, []V) {
+	if cycle := g.undirectedCycle(); cycle != nil {
+		return false, cycle
+	}
+
+	return true, nil
+}
+
+// IsTree returns true if the graph is a forest, as described in IsForest,
+// with exactly one connected component. If the graph has a cycle, it also
+// returns one as IsForest does. An empty graph is not a tree.
+func (g *Graph[V]) IsTree() (bool, []V) {
+	if forest, cycle := g.IsForest(); !forest {
+		return false, cycle
+	}
+	if len(g.vertices) == 0 {
+		return false, nil
+	}
+	if connected, _ := g.IsConnected(); !connected {
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// IsConnected returns true if there is a path between every pair of vertices
+// when edge direction is ignored. Otherwise it also returns a pair of
+// vertices with no path between them. An empty graph is connected.
+func (g *Graph[V]) IsConnected() (bool, Edge[V]) {
+	vertices := g.sortedVertices()
+	if len(vertices) == 0 {
+		return true, Edge[V]{}
+	}
+
+	reached := reach(vertices[0], g.orderedAdjacency(NoDirection))
+	for _, v := range vertices {
+		if !reached[v] {
+			return false, Edge[V]{From: vertices[0], To: v}
+		}
+	}
+
+	return true, Edge[V]{}
+}
+
+// IsStronglyConnected returns true if there is a directed path from every
+// vertex to every other vertex. Otherwise it also returns a pair of vertices
+// with no path from the first to the second. In an undirected graph, it is
+// the same as IsConnected.
+func (g *Graph[V]) IsStronglyConnected() (bool, Edge[V]) {
+	if !g.isDirected {
+		return g.IsConnected()
+	}
+
+	vertices := g.sortedVertices()
+	if len(vertices) == 0 {
+		return true, Edge[V]{}
+	}
+
+	// Every vertex is reachable from every other if and only if all of them
+	// can be reached from, and can reach, a single vertex.
+	forward := reach(vertices[0], g.orderedAdjacency(Outbound))
+	backward := reach(vertices[0], g.orderedAdjacency(Inbound))
+	for _, v := range vertices {
+		if !forward[v] {
+			return false, Edge[V]{From: vertices[0], To: v}
+		}
+		if !backward[v] {
+			return false, Edge[V]{From: v, To: vertices[0]}
+		}
+	}
+
+	return true, Edge[V]{}
+}
+
+// IsComplete returns true if every pair of distinct vertices is joined by an
+// edge, in both directions in a directed graph. Otherwise it also returns a
+// missing edge. Self-loops are ignored.
+func (g *Graph[V]) IsComplete() (bool, Edge[V]) {
+	vertices := g.sortedVertices()
+	for i, u := range vertices {
+		for _, v := range vertices[i+1:] {
+			if _, ok := g.adjacencyMap[u].Explicit[v]; !ok {
+				return false, Edge[V]{From: u, To: v}
+			}
+			if _, ok := g.adjacencyMap[v].Explicit[u]; !ok {
+				return false, Edge[V]{From: v, To: u}
+			}
+		}
+	}
+
+	return true, Edge[V]{}
+}
+
+// IsRegular returns true if every vertex has the same degree, and returns
+// that degree. In a directed graph, every vertex must have the same in-degree
+// and out-degree, and the returned degree is the common out-degree. In an
+// undirected graph, degrees are as reported by Degree. An empty graph is
+// regular with degree 0. If the graph is not regular, the returned degree is
+// 0.
+func (g *Graph[V]) IsRegular() (bool, int) {
+	degree, first := 0, true
+	for v := range g.vertices {
+		edges := g.adjacencyMap[v]
+		if first {
+			degree, first = len(edges.Explicit), false
+		}
+		if len(edges.Explicit) != degree || (g.isDirected && len(edges.Implicit) != degree) {
+			return false, 0
+		}
+	}
+
+	return true, degree
+}
+
+// IsDAG returns true if the graph is directed and has no cycles. If it has a
+// directed cycle, it also returns one as a list of distinct vertices, each
+// with an edge to the next and the last with an edge to the first. An
+// undirected graph is never a DAG, and no cycle is returned for it.
+func (g *Graph[V]) IsDAG() (bool, []V) {
+	if !g.isDirected {
+		return false, nil
+	}
+	if cycle := g.directedCycle(); cycle != nil {
+		return false, cycle
+	}
+
+	return true, nil
+}
+
+// IsEulerian returns true if the graph has an Eulerian circuit: a closed walk
+// that traverses every edge exactly once. Such a circuit exists if all edges
+// belong to one connected component and every vertex has even degree, or, in
+// a directed graph, equal in-degree and out-degree; isolated vertices do not
+// matter. If it exists, the circuit is also returned as the sequence of
+// vertices visited, starting and ending at the same vertex, computed with
+// Hierholzer's algorithm. A graph without edges is Eulerian, with an empty
+// circuit.
+func (g *Graph[V]) IsEulerian() (bool, []V) {
+	edges := 0
+	for v, e := range g.adjacencyMap {
+		degree := len(e.Explicit)
+		if _, loop := e.Explicit[v]; loop && !g.isDirected {
+			// A self-loop is stored once but adds 2 to the degree.
+			degree++
+		}
+		if g.isDirected && len(e.Implicit) != len(e.Explicit) {
+			return false, nil
+		}
+		if !g.isDirected && degree%2 != 0 {
+			return false, nil
+		}
+		edges += degree
+	}
+	if !g.isDirected {
+		edges /= 2
+	}
+	if edges == 0 {
+		return true, []V{}
+	}
+
+	circuit := g.eulerianCircuit()
+
+	// The circuit covers every edge only if all edges are connected.
+	if len(circuit) != edges+1 {
+		return false, nil
+	}
+
+	return true, circuit
+}
+
+// eulerianCircuit runs Hierholzer's algorithm from the first vertex with
+// edges, and returns the closed walk it finds. The walk covers every edge of
+// the component it starts in, provided every vertex has even degree (or equal
+// in-degree and out-degree).
+func (g *Graph[V]) eulerianCircuit() []V {
+	adjacency := g.orderedAdjacency(Outbound)
+	var start V
+	for _, v := range g.sortedVertices() {
+		if len(adjacency[v]) > 0 {
+			start = v
+			break
+		}
+	}
+
+	used := make(map[Edge[V]]bool)
+	next := make(map[V]int)
+	stack := []V{start}
+	circuit := make([]V, 0)
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		for next[u] < len(adjacency[u]) && used[g.edgeKey(u, adjacency[u][next[u]])] {
+			next[u]++
+		}
+		if next[u] == len(adjacency[u]) {
+			stack = stack[:len(stack)-1]
+			circuit = append(circuit, u)
+			continue
+		}
+		v := adjacency[u][next[u]]
+		used[g.edgeKey(u, v)] = true
+		stack = append(stack, v)
+	}
+
+	for i, j := 0, len(circuit)-1; i < j; i, j = i+1, j-1 {
+		circuit[i], circuit[j] = circuit[j], circuit[i]
+	}
+
+	return circuit
+}
+
+// undirectedCycle returns a cycle of the graph with edge direction ignored,
+// or nil if there is none.
+func (g *Graph[V]) undirectedCycle() []V {
+	vertices := g.sortedVertices()
+	for _, v := range vertices {
+		if _, ok := g.adjacencyMap[v].Explicit[v]; ok {
+			return []V{v}
+		}
+	}
+	if g.isDirected {
+		outbound := g.orderedAdjacency(Outbound)
+		for _, u := range vertices {
+			for _, v := range outbound[u] {
+				if _, ok := g.adjacencyMap[u].Implicit[v]; ok {
+					return []V{u, v}
+				}
+			}
+		}
+	}
+
+	// Without self-loops and opposite edges, the graph is simple when
+	// direction is ignored, and a cycle is found at the first edge that
+	// leads back to a visited vertex other than the parent.
+	adjacency := g.orderedAdjacency(NoDirection)
+	parent := make(map[V]V)
+	visited := make(map[V]bool)
+	var visit func(u V) []V
+	visit = func(u V) []V {
+		visited[u] = true
+		for _, w := range adjacency[u] {
+			if p, ok := parent[u]; ok && p == w {
+				continue
+			}
+			if visited[w] {
+				cycle := []V{u}
+				for v := u; v != w; {
+					v = parent[v]
+					cycle = append(cycle, v)
+				}
+				for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+					cycle[i], cycle[j] = cycle[j], cycle[i]
+				}
+				return cycle
+			}
+			parent[w] = u
+			if cycle := visit(w); cycle != nil {
+				return cycle
+			}
+		}
+		return nil
+	}
+	for _, v := range vertices {
+		if !visited[v] {
+			if cycle := visit(v); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
+
+// directedCycle returns a directed cycle of the graph, or nil if there is
+// none.
+func (g *Graph[V]) directedCycle() []V {
+	adjacency := g.orderedAdjacency(Outbound)
+	onPath := make(map[V]int)
+	done := make(map[V]bool)
+	path := make([]V, 0)
+	var visit func(u V) []V
+	visit = func(u V) []V {
+		onPath[u] = len(path)
+		path = append(path, u)
+		for _, w := range adjacency[u] {
+			if i, ok := onPath[w]; ok {
+				return append([]V{}, path[i:]...)
+			}
+			if !done[w] {
+				if cycle := visit(w); cycle != nil {
+					return cycle
+				}
+			}
+		}
+		delete(onPath, u)
+		path = path[:len(path)-1]
+		done[u] = true
+		return nil
+	}
+	for _, v := range g.sortedVertices() {
+		if !done[v] {
+			if cycle := visit(v); cycle != nil {
+				return cycle
+			}
+		}
+	}
+
+	return nil
+}
+
+// orderedAdjacency returns the neighbors of every vertex in the order of
+// sortedVertices. In a directed graph, d selects the outbound neighbors
+// (Outbound), the inbound neighbors (Inbound), or both (NoDirection); in an
+// undirected graph d is ignored.
+func (g *Graph[V]) orderedAdjacency(d Direction) map[V][]V {
+	vertices := g.sortedVertices()
+	index := make(map[V]int, len(vertices))
+	for i, v := range vertices {
+		index[v] = i
+	}
+
+	adjacency := make(map[V][]V, len(vertices))
+	for _, u := range vertices {
+		neighbors := make(set[V])
+		if !g.isDirected || d != Inbound {
+			for v := range g.adjacencyMap[u].Explicit {
+				neighbors[v] = true
+			}
+		}
+		if g.isDirected && d != Outbound {
+			for v := range g.adjacencyMap[u].Implicit {
+				neighbors[v] = true
+			}
+		}
+		for v := range neighbors {
+			adjacency[u] = append(adjacency[u], v)
+		}
+		sort.Slice(adjacency[u], func(i, j int) bool {
+			return index[adjacency[u][i]] < index[adjacency[u][j]]
+		})
+	}
+
+	return adjacency
+}
+
+// reach returns the set of vertices reachable from s by following adjacency.
+func reach[V comparable](s V, adjacency map[V][]V) map[V]bool {
+	reached := map[V]bool{s: true}
+	stack := []V{s}
+	for len(stack) > 0 {
+		u := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for _, v := range adjacency[u] {
+			if !reached[v] {
+				reached[v] = true
+				stack = append(stack, v)
+			}
+		}
+	}
+
+	return reached
+}
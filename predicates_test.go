@@ -0,0 +1,455 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestIsForest(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantCycle   []int
+	}{
+		{
+			description: "path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        true,
+		},
+		{
+			description: "two trees",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{3, 4}),
+			want:        true,
+		},
+		{
+			description: "triangle",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        false,
+			wantCycle:   []int{1, 2, 3},
+		},
+		{
+			description: "self-loop",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{1, 1}),
+			want:        false,
+			wantCycle:   []int{1},
+		},
+		{
+			description: "opposite edges",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 1}),
+			want:        false,
+			wantCycle:   []int{1, 2},
+		},
+		{
+			description: "directed diamond",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 4}, [2]int{3, 4}),
+			want:        false,
+			wantCycle:   []int{1, 2, 4, 3},
+		},
+		{
+			description: "empty",
+			input:       NewGraph[int](false),
+			want:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, cycle := test.input.IsForest()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if !cmp.Equal(cycle, test.wantCycle) {
+				t.Errorf("%v != %v", cycle, test.wantCycle)
+			}
+		})
+	}
+}
+
+func TestIsTree(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantCycle   []int
+	}{
+		{
+			description: "path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        true,
+		},
+		{
+			description: "directed out-tree",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{1, 3}),
+			want:        true,
+		},
+		{
+			description: "two trees",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{3, 4}),
+			want:        false,
+		},
+		{
+			description: "triangle",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        false,
+			wantCycle:   []int{1, 2, 3},
+		},
+		{
+			description: "empty",
+			input:       NewGraph[int](false),
+			want:        false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, cycle := test.input.IsTree()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if !cmp.Equal(cycle, test.wantCycle) {
+				t.Errorf("%v != %v", cycle, test.wantCycle)
+			}
+		})
+	}
+}
+
+func TestIsConnected(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantPair    Edge[int]
+	}{
+		{
+			description: "path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        true,
+		},
+		{
+			description: "two components",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{3, 4}),
+			want:        false,
+			wantPair:    Edge[int]{From: 1, To: 3},
+		},
+		{
+			description: "weakly connected",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{3, 2}),
+			want:        true,
+		},
+		{
+			description: "empty",
+			input:       NewGraph[int](true),
+			want:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, pair := test.input.IsConnected()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if pair != test.wantPair {
+				t.Errorf("%v != %v", pair, test.wantPair)
+			}
+		})
+	}
+}
+
+func TestIsStronglyConnected(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantPair    Edge[int]
+	}{
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        true,
+		},
+		{
+			description: "cannot return",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			want:        false,
+			wantPair:    Edge[int]{From: 2, To: 1},
+		},
+		{
+			description: "cannot reach",
+			input:       edgeListGraph(true, [2]int{2, 1}, [2]int{2, 3}),
+			want:        false,
+			wantPair:    Edge[int]{From: 1, To: 2},
+		},
+		{
+			description: "undirected",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, pair := test.input.IsStronglyConnected()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if pair != test.wantPair {
+				t.Errorf("%v != %v", pair, test.wantPair)
+			}
+		})
+	}
+}
+
+func TestIsComplete(t *testing.T) {
+	single := NewGraph[int](false)
+	_ = single.AddVertex(1)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantMissing Edge[int]
+	}{
+		{
+			description: "triangle",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        true,
+		},
+		{
+			description: "path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        false,
+			wantMissing: Edge[int]{From: 1, To: 3},
+		},
+		{
+			description: "one direction",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			want:        false,
+			wantMissing: Edge[int]{From: 2, To: 1},
+		},
+		{
+			description: "single vertex",
+			input:       single,
+			want:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, missing := test.input.IsComplete()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if missing != test.wantMissing {
+				t.Errorf("%v != %v", missing, test.wantMissing)
+			}
+		})
+	}
+}
+
+func TestIsRegular(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantDegree  int
+	}{
+		{
+			description: "cycle",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 1}),
+			want:        true,
+			wantDegree:  2,
+		},
+		{
+			description: "path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        false,
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        true,
+			wantDegree:  1,
+		},
+		{
+			description: "directed edge",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			want:        false,
+		},
+		{
+			description: "empty",
+			input:       NewGraph[int](false),
+			want:        true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, degree := test.input.IsRegular()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if degree != test.wantDegree {
+				t.Errorf("%v != %v", degree, test.wantDegree)
+			}
+		})
+	}
+}
+
+func TestIsDAG(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantCycle   []int
+	}{
+		{
+			description: "diamond",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 4}, [2]int{3, 4}),
+			want:        true,
+		},
+		{
+			description: "cycle",
+			input:       edgeListGraph(true, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        false,
+			wantCycle:   []int{1, 2, 3},
+		},
+		{
+			description: "self-loop",
+			input:       edgeListGraph(true, [2]int{1, 1}),
+			want:        false,
+			wantCycle:   []int{1},
+		},
+		{
+			description: "undirected",
+			input:       edgeListGraph(false, [2]int{1, 2}),
+			want:        false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, cycle := test.input.IsDAG()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if !cmp.Equal(cycle, test.wantCycle) {
+				t.Errorf("%v != %v", cycle, test.wantCycle)
+			}
+		})
+	}
+}
+
+// isEulerianCircuit returns true if circuit is a closed walk in g that uses
+// every edge exactly once.
+func isEulerianCircuit[V comparable](g Graph[V], circuit []V) bool {
+	if len(circuit) == 0 || circuit[0] != circuit[len(circuit)-1] {
+		return false
+	}
+	used := make(map[Edge[V]]bool)
+	for i := 1; i < len(circuit); i++ {
+		e := g.edgeKey(circuit[i-1], circuit[i])
+		if _, ok := g.adjacencyMap[e.From].Explicit[e.To]; !ok || used[e] {
+			return false
+		}
+		used[e] = true
+	}
+	return len(used) == len(g.GetAllEdges())
+}
+
+func TestIsEulerian(t *testing.T) {
+	isolated := edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1})
+	_ = isolated.AddVertex(4)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        bool
+		wantCircuit []int
+	}{
+		{
+			description: "triangle",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        true,
+			wantCircuit: []int{1, 2, 3, 1},
+		},
+		{
+			description: "isolated vertex",
+			input:       isolated,
+			want:        true,
+			wantCircuit: []int{1, 2, 3, 1},
+		},
+		{
+			description: "self-loop",
+			input:       edgeListGraph(false, [2]int{1, 1}),
+			want:        true,
+			wantCircuit: []int{1, 1},
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			want:        true,
+			wantCircuit: []int{1, 2, 3, 1},
+		},
+		{
+			description: "path",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}),
+			want:        false,
+		},
+		{
+			description: "two triangles",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{4, 5}, [2]int{5, 6}, [2]int{6, 4}),
+			want:        false,
+		},
+		{
+			description: "directed edge",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			want:        false,
+		},
+		{
+			description: "no edges",
+			input:       NewGraph[int](false),
+			want:        true,
+			wantCircuit: []int{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, circuit := test.input.IsEulerian()
+
+			if got != test.want {
+				t.Errorf("%v != %v", got, test.want)
+			}
+			if !cmp.Equal(circuit, test.wantCircuit) {
+				t.Errorf("%v != %v", circuit, test.wantCircuit)
+			}
+		})
+	}
+}
+
+func TestIsEulerianCircuit(t *testing.T) {
+	// Two triangles sharing vertex 1, and the same with a self-loop on 3.
+	bowtie := edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{1, 4}, [2]int{4, 5}, [2]int{5, 1})
+	looped := edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{1, 4}, [2]int{4, 5}, [2]int{5, 1}, [2]int{3, 3})
+	directed := edgeListGraph(true, [2]int{1, 2}, [2]int{2, 1}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 2}, [2]int{1, 5}, [2]int{5, 1})
+
+	for _, g := range []Graph[int]{bowtie, looped, directed} {
+		got, circuit := g.IsEulerian()
+		if !got {
+			t.Fatalf("%v is not Eulerian", g)
+		}
+		if !isEulerianCircuit(g, circuit) {
+			t.Errorf("%v is not an Eulerian circuit of %v", circuit, g)
+		}
+	}
+}
@@ -0,0 +1,208 @@
+package graph
+
+import "fmt"
+
+// Modularity returns the modularity of partition, which maps every vertex of
+// the graph to a community: the fraction of the total edge weight that falls
+// within communities, minus the fraction expected if edges were placed at
+// random while preserving the weighted degree of every vertex. It ranges from
+// -1/2 to 1, and a value near 0 means the partition captures no more structure
+// than chance. In a directed graph, the expected fraction preserves in- and
+// out-degrees (Leicht and Newman, 2008). A self-loop adds its weight to both
+// of its endpoints. A graph without edge weight has a modularity of 0. If
+// partition does not cover the graph as described in Coverage, or an edge has
+// a negative weight, it returns an error.
+func (g *Graph[V]) Modularity(partition map[V]int) (float64, error) {
+	if err := g.validatePartition(partition); err != nil {
+		return 0, err
+	}
+
+	total := 0.0
+	internal := make(map[int]float64)
+	outbound := make(map[int]float64)
+	inbound := make(map[int]float64)
+	for u, edges := range g.adjacencyMap {
+		for v, weight := range edges.Explicit {
+			if weight < 0 {
+				return 0, InvalidArgumentErr{fmt.Sprintf("%v", weight), "edge weights must be non-negative"}
+			}
+
+			// An undirected edge between distinct vertices is stored in both
+			// directions, so each direction carries half of its weight, and
+			// the outbound and inbound weight of a community are both half
+			// of its degree.
+			if !g.isDirected && u != v {
+				weight /= 2
+			}
+			total += weight
+			outbound[partition[u]] += weight
+			inbound[partition[v]] += weight
+			if partition[u] == partition[v] {
+				internal[partition[u]] += weight
+			}
+		}
+	}
+	if total == 0 {
+		return 0, nil
+	}
+
+	q := 0.0
+	for c := range outbound {
+		q += internal[c]/total - outbound[c]*inbound[c]/(total*total)
+	}
+
+	return q, nil
+}
+
+// Conductance returns the conductance of the cut between set and the rest of
+// the graph: the weight of the edges crossing the cut, divided by the smaller
+// of the volumes of the two sides, where the volume of a side is the total
+// weighted degree of its vertices. A low conductance means set is well
+// separated from the rest of the graph. Edge direction is ignored. If set
+// contains a vertex that is not in the graph, it returns MissingVertexErr. If
+// either side of the cut has a volume of 0, for example because set is empty
+// or contains every vertex, it returns InvalidArgumentErr.
+func (g *Graph[V]) Conductance(set []V) (float64, error) {
+	inside := make(map[V]bool, len(set))
+	for _, v := range set {
+		if _, ok := g.vertices[v]; !ok {
+			return 0, &MissingVertexErr[V]{v}
+		}
+		inside[v] = true
+	}
+
+	cut, insideVolume, outsideVolume := 0.0, 0.0, 0.0
+	for u, edges := range g.adjacencyMap {
+		for v, weight := range edges.Explicit {
+			if weight < 0 {
+				return 0, InvalidArgumentErr{fmt.Sprintf("%v", weight), "edge weights must be non-negative"}
+			}
+
+			// Every edge adds its weight to the volume of both endpoints. An
+			// undirected edge between distinct vertices is stored in both
+			// directions, so each direction carries half of its weight.
+			if !g.isDirected && u != v {
+				weight /= 2
+			}
+			for _, w := range []V{u, v} {
+				if inside[w] {
+					insideVolume += weight
+				} else {
+					outsideVolume += weight
+				}
+			}
+			if inside[u] != inside[v] {
+				cut += weight
+			}
+		}
+	}
+
+	volume := insideVolume
+	if outsideVolume < volume {
+		volume = outsideVolume
+	}
+	if volume == 0 {
+		return 0, InvalidArgumentErr{fmt.Sprintf("%v", set), "both sides of the cut must have edges"}
+	}
+
+	return cut / volume, nil
+}
+
+// Coverage returns the fraction of edges whose endpoints are in the same
+// community of partition. Every vertex of the graph must be mapped to a
+// community. Edge weights and self-loops are ignored, and a graph without
+// other edges has a coverage of 0. If partition maps a vertex that is not in
+// the graph, it returns MissingVertexErr. If it does not map every vertex of
+// the graph, it returns InvalidArgumentErr.
+func (g *Graph[V]) Coverage(partition map[V]int) (float64, error) {
+	if err := g.validatePartition(partition); err != nil {
+		return 0, err
+	}
+
+	internal, total := g.countPartitionEdges(partition)
+	if total == 0 {
+		return 0, nil
+	}
+
+	return float64(internal) / float64(total), nil
+}
+
+// Performance returns the fraction of pairs of distinct vertices that
+// partition classifies correctly: pairs in the same community joined by an
+// edge, and pairs in different communities not joined by one. In a directed
+// graph, pairs are ordered. Edge weights and self-loops are ignored, and a
+// graph with fewer than two vertices has a performance of 0. The partition
+// must cover the graph as described in Coverage.
+func (g *Graph[V]) Performance(partition map[V]int) (float64, error) {
+	if err := g.validatePartition(partition); err != nil {
+		return 0, err
+	}
+
+	n := len(g.vertices)
+	pairs := n * (n - 1)
+	if !g.isDirected {
+		pairs /= 2
+	}
+	if pairs == 0 {
+		return 0, nil
+	}
+
+	sizes := make(map[int]int)
+	for _, c := range partition {
+		sizes[c]++
+	}
+	internalPairs := 0
+	for _, size := range sizes {
+		internalPairs += size * (size - 1)
+	}
+	if !g.isDirected {
+		internalPairs /= 2
+	}
+
+	internal, total := g.countPartitionEdges(partition)
+	external := total - internal
+	correct := internal + (pairs - internalPairs - external)
+
+	return float64(correct) / float64(pairs), nil
+}
+
+// countPartitionEdges returns the number of edges within communities of
+// partition and the total number of edges, ignoring self-loops.
+func (g *Graph[V]) countPartitionEdges(partition map[V]int) (int, int) {
+	internal, total := 0, 0
+	for u, edges := range g.adjacencyMap {
+		for v := range edges.Explicit {
+			if u == v {
+				continue
+			}
+			total++
+			if partition[u] == partition[v] {
+				internal++
+			}
+		}
+	}
+	if !g.isDirected {
+		internal /= 2
+		total /= 2
+	}
+
+	return internal, total
+}
+
+// validatePartition returns MissingVertexErr if partition maps a vertex that
+// is not in the graph, and InvalidArgumentErr if it does not map every vertex
+// of the graph.
+func (g *Graph[V]) validatePartition(partition map[V]int) error {
+	for v := range partition {
+		if _, ok := g.vertices[v]; !ok {
+			return &MissingVertexErr[V]{v}
+		}
+	}
+	for v := range g.vertices {
+		if _, ok := partition[v]; !ok {
+			return InvalidArgumentErr{fmt.Sprintf("%v", v), "vertex is not assigned to a community"}
+		}
+	}
+
+	return nil
+}
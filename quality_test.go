@@ -0,0 +1,323 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// twoTriangles returns the triangles 0-1-2 and 3-4-5 joined by an edge from 2
+// to 3.
+func twoTriangles(isDirected bool) Graph[int] {
+	return edgeListGraph(isDirected,
+		[2]int{0, 1}, [2]int{1, 2}, [2]int{2, 0},
+		[2]int{3, 4}, [2]int{4, 5}, [2]int{5, 3},
+		[2]int{2, 3})
+}
+
+var trianglesPartition = map[int]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1}
+
+func TestModularity(t *testing.T) {
+	square := NewGraph[int](false)
+	_ = square.AddEdge(0, 1, 10)
+	_ = square.AddEdge(1, 2, 1)
+	_ = square.AddEdge(2, 3, 10)
+	_ = square.AddEdge(3, 0, 1)
+
+	loop := NewGraph[int](false)
+	_ = loop.AddEdge(0, 0, 1)
+	_ = loop.AddEdge(0, 1, 1)
+
+	unweighted := NewGraph[int](false)
+	_ = unweighted.AddEdge(0, 1, 0)
+
+	negative := NewGraph[int](false)
+	_ = negative.AddEdge(0, 1, -1)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		partition   map[int]int
+		want        float64
+		wantError   error
+	}{
+		{
+			description: "two triangles",
+			input:       twoTriangles(false),
+			partition:   trianglesPartition,
+			want:        6.0/7 - 0.5,
+		},
+		{
+			description: "single community",
+			input:       twoTriangles(false),
+			partition:   map[int]int{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+			want:        0,
+		},
+		{
+			description: "singletons",
+			input:       edgeListGraph(false, [2]int{0, 1}, [2]int{1, 2}, [2]int{2, 0}),
+			partition:   map[int]int{0: 0, 1: 1, 2: 2},
+			want:        -1.0 / 3,
+		},
+		{
+			description: "weighted",
+			input:       square,
+			partition:   map[int]int{0: 0, 1: 0, 2: 1, 3: 1},
+			want:        20.0/22 - 0.5,
+		},
+		{
+			description: "self-loop",
+			input:       loop,
+			partition:   map[int]int{0: 0, 1: 1},
+			want:        0.5 - 0.5625 - 0.0625,
+		},
+		{
+			description: "directed",
+			input:       twoTriangles(true),
+			partition:   trianglesPartition,
+			want:        18.0 / 49,
+		},
+		{
+			description: "no edge weight",
+			input:       unweighted,
+			partition:   map[int]int{0: 0, 1: 1},
+			want:        0,
+		},
+		{
+			description: "unassigned vertex",
+			input:       twoTriangles(false),
+			partition:   map[int]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1},
+			wantError:   InvalidArgumentErr{"5", "vertex is not assigned to a community"},
+		},
+		{
+			description: "missing vertex",
+			input:       twoTriangles(false),
+			partition:   map[int]int{0: 0, 1: 0, 2: 0, 3: 1, 4: 1, 5: 1, 6: 1},
+			wantError:   &MissingVertexErr[int]{},
+		},
+		{
+			description: "negative weight",
+			input:       negative,
+			partition:   map[int]int{0: 0, 1: 1},
+			wantError:   InvalidArgumentErr{"-1", "edge weights must be non-negative"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Modularity(test.partition)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestConductance(t *testing.T) {
+	square := NewGraph[int](false)
+	_ = square.AddEdge(0, 1, 10)
+	_ = square.AddEdge(1, 2, 1)
+	_ = square.AddEdge(2, 3, 10)
+	_ = square.AddEdge(3, 0, 1)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		set         []int
+		want        float64
+		wantError   error
+	}{
+		{
+			description: "two triangles",
+			input:       twoTriangles(false),
+			set:         []int{0, 1, 2},
+			want:        1.0 / 7,
+		},
+		{
+			description: "smaller side",
+			input:       twoTriangles(false),
+			set:         []int{0},
+			want:        1,
+		},
+		{
+			description: "weighted",
+			input:       square,
+			set:         []int{0, 1},
+			want:        2.0 / 22,
+		},
+		{
+			description: "directed",
+			input:       twoTriangles(true),
+			set:         []int{3, 4, 5},
+			want:        1.0 / 7,
+		},
+		{
+			description: "empty set",
+			input:       twoTriangles(false),
+			set:         []int{},
+			wantError:   InvalidArgumentErr{"[]", "both sides of the cut must have edges"},
+		},
+		{
+			description: "missing vertex",
+			input:       twoTriangles(false),
+			set:         []int{6},
+			wantError:   &MissingVertexErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Conductance(test.set)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	isolated := NewGraph[int](false)
+	_ = isolated.AddVertices(0, 1)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		partition   map[int]int
+		want        float64
+		wantError   error
+	}{
+		{
+			description: "two triangles",
+			input:       twoTriangles(false),
+			partition:   trianglesPartition,
+			want:        6.0 / 7,
+		},
+		{
+			description: "singletons",
+			input:       twoTriangles(false),
+			partition:   map[int]int{0: 0, 1: 1, 2: 2, 3: 3, 4: 4, 5: 5},
+			want:        0,
+		},
+		{
+			description: "directed",
+			input:       twoTriangles(true),
+			partition:   trianglesPartition,
+			want:        6.0 / 7,
+		},
+		{
+			description: "no edges",
+			input:       isolated,
+			partition:   map[int]int{0: 0, 1: 0},
+			want:        0,
+		},
+		{
+			description: "unassigned vertex",
+			input:       isolated,
+			partition:   map[int]int{0: 0},
+			wantError:   InvalidArgumentErr{"1", "vertex is not assigned to a community"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Coverage(test.partition)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestPerformance(t *testing.T) {
+	single := NewGraph[int](false)
+	_ = single.AddVertex(0)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		partition   map[int]int
+		want        float64
+		wantError   error
+	}{
+		{
+			description: "two triangles",
+			input:       twoTriangles(false),
+			partition:   trianglesPartition,
+			want:        14.0 / 15,
+		},
+		{
+			description: "single community",
+			input:       twoTriangles(false),
+			partition:   map[int]int{0: 0, 1: 0, 2: 0, 3: 0, 4: 0, 5: 0},
+			want:        7.0 / 15,
+		},
+		{
+			description: "directed",
+			input:       twoTriangles(true),
+			partition:   trianglesPartition,
+			want:        23.0 / 30,
+		},
+		{
+			description: "single vertex",
+			input:       single,
+			partition:   map[int]int{0: 0},
+			want:        0,
+		},
+		{
+			description: "missing vertex",
+			input:       single,
+			partition:   map[int]int{0: 0, 1: 0},
+			wantError:   &MissingVertexErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.Performance(test.partition)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want, cmpopts.EquateApprox(0, 1e-9)) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
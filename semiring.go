@@ -0,0 +1,183 @@
+package graph
+
+import "math"
+
+// A Semiring describes how to combine path values of type W, so that one path
+// algorithm can answer different questions about the paths of a graph. Times
+// extends a path by an edge, and Plus chooses between, or adds up, two
+// alternative paths. Zero is the value of no path at all: the identity of Plus
+// and a value that Times turns every other value into. One is the value of
+// the empty path from a vertex to itself: the identity of Times.
+//
+// With Plus as min and Times as +, for example, the value of a set of paths
+// is the length of the shortest one, and with Plus as + and Times as *, over
+// edges of value 1, it is the number of paths.
+type Semiring[W any] interface {
+	Plus(a, b W) W
+	Times(a, b W) W
+	Zero() W
+	One() W
+}
+
+// MinPlusSemiring combines paths by length: the value of a path is the sum of
+// its edge weights, and the shortest path wins.
+type MinPlusSemiring struct{}
+
+func (MinPlusSemiring) Plus(a, b float64) float64  { return math.Min(a, b) }
+func (MinPlusSemiring) Times(a, b float64) float64 { return a + b }
+func (MinPlusSemiring) Zero() float64              { return math.Inf(1) }
+func (MinPlusSemiring) One() float64               { return 0 }
+
+// MaxMinSemiring combines paths by width: the value of a path is its smallest
+// edge weight, and the widest path wins, as in WidestPath.
+type MaxMinSemiring struct{}
+
+func (MaxMinSemiring) Plus(a, b float64) float64  { return math.Max(a, b) }
+func (MaxMinSemiring) Times(a, b float64) float64 { return math.Min(a, b) }
+func (MaxMinSemiring) Zero() float64              { return math.Inf(-1) }
+func (MaxMinSemiring) One() float64               { return math.Inf(1) }
+
+// BooleanSemiring combines paths by existence: a vertex has the value true if
+// any path reaches it.
+type BooleanSemiring struct{}
+
+func (BooleanSemiring) Plus(a, b bool) bool  { return a || b }
+func (BooleanSemiring) Times(a, b bool) bool { return a && b }
+func (BooleanSemiring) Zero() bool           { return false }
+func (BooleanSemiring) One() bool            { return true }
+
+// CountingSemiring counts paths: with every edge given the value 1, a vertex
+// has the number of paths that reach it.
+type CountingSemiring struct{}
+
+func (CountingSemiring) Plus(a, b int) int  { return a + b }
+func (CountingSemiring) Times(a, b int) int { return a * b }
+func (CountingSemiring) Zero() int          { return 0 }
+func (CountingSemiring) One() int           { return 1 }
+
+// ProbabilitySemiring combines paths by reliability: the value of a path is
+// the product of its edge probabilities, and the most reliable path wins, as
+// in MostReliablePath.
+type ProbabilitySemiring struct{}
+
+func (ProbabilitySemiring) Plus(a, b float64) float64  { return math.Max(a, b) }
+func (ProbabilitySemiring) Times(a, b float64) float64 { return a * b }
+func (ProbabilitySemiring) Zero() float64              { return 0 }
+func (ProbabilitySemiring) One() float64               { return 1 }
+
+// SemiringShortestPaths returns, for every vertex v of g, the Plus of the
+// values of the paths from source to v, where the value of a path is the
+// Times of the values that weight assigns to its edge weights. Vertices that
+// source cannot reach have the value Zero, and source itself has the Plus of
+// One, for the empty path, and the values of any cycles through it. In a
+// directed acyclic graph, every path is combined exactly once by a single
+// pass over the vertices in topological order, in O(V + E) time.
+//
+// Otherwise, the values of the walks from source are combined in rounds like
+// those of the Bellman-Ford algorithm, each adding the walks one edge longer,
+// until a round changes no value. For semirings like MinPlusSemiring, in
+// which going around a cycle never gives a better value, this takes at most
+// |V| - 1 rounds and O(VE) time, and the result is the same as combining all
+// paths. If values still change after |V| rounds, because a cycle keeps
+// adding to them, as with a negative cycle under MinPlusSemiring or any
+// reachable cycle under CountingSemiring, it returns ConvergenceErr. If
+// source does not exist in the graph, it returns MissingVertexErr.
+func SemiringShortestPaths[V comparable, W comparable](g *Graph[V], source V, s Semiring[W], weight func(float64) W) (map[V]W, error) {
+	if _, ok := g.vertices[source]; !ok {
+		return nil, &MissingVertexErr[V]{v: source}
+	}
+
+	return semiringPaths(g, source, s, weight, g.semiringOrder())
+}
+
+// SemiringClosure returns, for every pair of vertices u and v of g, the Plus
+// of the values of the paths from u to v, computed as by
+// SemiringShortestPaths from every vertex. With BooleanSemiring, for example,
+// it is the transitive closure of the graph. If the values from some vertex
+// do not converge, it returns ConvergenceErr.
+func SemiringClosure[V comparable, W comparable](g *Graph[V], s Semiring[W], weight func(float64) W) (map[V]map[V]W, error) {
+	order := g.semiringOrder()
+
+	closure := make(map[V]map[V]W, len(g.vertices))
+	for u := range g.vertices {
+		values, err := semiringPaths(g, u, s, weight, order)
+		if err != nil {
+			return nil, err
+		}
+		closure[u] = values
+	}
+
+	return closure, nil
+}
+
+// semiringOrder returns a topological order of the vertices of g, or nil if g
+// is undirected or has a cycle, in which case semiringPaths combines walks
+// round by round instead.
+func (g *Graph[V]) semiringOrder() []V {
+	order, err := g.TopologicalSort()
+	if err != nil {
+		return nil
+	}
+
+	return order
+}
+
+// semiringPaths combines the values of the paths from source in a single pass
+// over order, a topological order of the vertices, or, if order is nil, over
+// walks of increasing length until the values stop changing.
+func semiringPaths[V comparable, W comparable](g *Graph[V], source V, s Semiring[W], weight func(float64) W, order []V) (map[V]W, error) {
+	values := make(map[V]W, len(g.vertices))
+	for v := range g.vertices {
+		values[v] = s.Zero()
+	}
+	values[source] = s.One()
+
+	if order != nil {
+		reached := set[V]{source: true}
+		for _, u := range order {
+			if !reached[u] {
+				continue
+			}
+			for v, w := range g.adjacencyMap[u].Explicit {
+				values[v] = s.Plus(values[v], s.Times(values[u], weight(w)))
+				reached[v] = true
+			}
+		}
+
+		return values, nil
+	}
+
+	// frontier holds the combined values of the walks with exactly as many
+	// edges as the rounds so far. A round that changes no value leaves every
+	// later round nothing to change either, so the values have converged.
+	frontier := map[V]W{source: s.One()}
+	for round := 1; len(frontier) > 0; round++ {
+		next := make(map[V]W)
+		for u, x := range frontier {
+			for v, w := range g.adjacencyMap[u].Explicit {
+				y, ok := next[v]
+				if !ok {
+					y = s.Zero()
+				}
+				next[v] = s.Plus(y, s.Times(x, weight(w)))
+			}
+		}
+
+		changed := false
+		for v, x := range next {
+			if y := s.Plus(values[v], x); y != values[v] {
+				values[v] = y
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+		if round >= len(g.vertices) {
+			return nil, ConvergenceErr{round}
+		}
+		frontier = next
+	}
+
+	return values, nil
+}
@@ -0,0 +1,209 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func edgeWeight(w float64) float64 { return w }
+func edgeExists(float64) bool      { return true }
+func edgeCount(float64) int        { return 1 }
+
+func TestSemiringShortestPaths(t *testing.T) {
+	diamond := edgeListGraph(true, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 4}, [2]int{3, 4}, [2]int{4, 5}, [2]int{1, 5})
+	_ = diamond.AddVertex(6)
+	cycle := edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1})
+
+	t.Run("min-plus on a DAG", func(t *testing.T) {
+		g := weightedDAG()
+		got, err := SemiringShortestPaths(&g, "s", MinPlusSemiring{}, edgeWeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]float64{"r": math.Inf(1), "s": 0, "t": 2, "x": 6, "y": 5, "z": 3}
+		if !cmp.Equal(got, want) {
+			t.Errorf("%v != %v", got, want)
+		}
+	})
+
+	t.Run("counting on a DAG", func(t *testing.T) {
+		got, err := SemiringShortestPaths(&diamond, 1, CountingSemiring{}, edgeCount)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[int]int{1: 1, 2: 1, 3: 1, 4: 2, 5: 3, 6: 0}
+		if !cmp.Equal(got, want) {
+			t.Errorf("%v != %v", got, want)
+		}
+	})
+
+	t.Run("counting on a cycle", func(t *testing.T) {
+		_, err := SemiringShortestPaths(&cycle, 1, CountingSemiring{}, edgeCount)
+		if !cmp.Equal(err, ConvergenceErr{3}, cmpopts.EquateErrors()) {
+			t.Errorf("%#v != %#v", err, ConvergenceErr{3})
+		}
+	})
+
+	t.Run("counting on an undirected path", func(t *testing.T) {
+		g := edgeListGraph(false, [2]string{"a", "b"}, [2]string{"b", "c"})
+		_, err := SemiringShortestPaths(&g, "a", CountingSemiring{}, edgeCount)
+		if !cmp.Equal(err, ConvergenceErr{3}, cmpopts.EquateErrors()) {
+			t.Errorf("%#v != %#v", err, ConvergenceErr{3})
+		}
+	})
+
+	t.Run("min-plus with a negative cycle", func(t *testing.T) {
+		g := NewGraph[string](true)
+		_ = g.AddEdge("s", "a", 1)
+		_ = g.AddEdge("a", "b", -2)
+		_ = g.AddEdge("b", "a", 1)
+		_, err := SemiringShortestPaths(&g, "s", MinPlusSemiring{}, edgeWeight)
+		if !cmp.Equal(err, ConvergenceErr{3}, cmpopts.EquateErrors()) {
+			t.Errorf("%#v != %#v", err, ConvergenceErr{3})
+		}
+	})
+
+	t.Run("min-plus on a cycle", func(t *testing.T) {
+		g := NewGraph[string](true)
+		_ = g.AddEdge("s", "a", 1)
+		_ = g.AddEdge("a", "b", 2)
+		_ = g.AddEdge("b", "s", 3)
+		got, err := SemiringShortestPaths(&g, "s", MinPlusSemiring{}, edgeWeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]float64{"s": 0, "a": 1, "b": 3}
+		if !cmp.Equal(got, want) {
+			t.Errorf("%v != %v", got, want)
+		}
+	})
+
+	t.Run("boolean on a cycle", func(t *testing.T) {
+		g := edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{4, 1})
+		got, err := SemiringShortestPaths(&g, 1, BooleanSemiring{}, edgeExists)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[int]bool{1: true, 2: true, 3: true, 4: false}
+		if !cmp.Equal(got, want) {
+			t.Errorf("%v != %v", got, want)
+		}
+	})
+
+	t.Run("probability", func(t *testing.T) {
+		g := NewGraph[string](true)
+		_ = g.AddEdge("s", "a", 0.75)
+		_ = g.AddEdge("a", "t", 0.75)
+		_ = g.AddEdge("s", "t", 0.5)
+		_ = g.AddEdge("t", "s", 0.5)
+		got, err := SemiringShortestPaths(&g, "s", ProbabilitySemiring{}, edgeWeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := map[string]float64{"s": 1, "a": 0.75, "t": 0.5625}
+		if !cmp.Equal(got, want) {
+			t.Errorf("%v != %v", got, want)
+		}
+	})
+
+	t.Run("missing vertex", func(t *testing.T) {
+		_, err := SemiringShortestPaths(&cycle, 4, BooleanSemiring{}, edgeExists)
+		if !cmp.Equal(err, &MissingVertexErr[int]{}, cmpopts.EquateErrors()) {
+			t.Errorf("%#v != %#v", err, &MissingVertexErr[int]{})
+		}
+	})
+}
+
+func TestSemiringShortestPathsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 100; i++ {
+		g := randomWeightedGraph(r, i%2 == 0, 10, 0.2)
+		source := r.Intn(10)
+
+		shortest, _ := g.Dijkstra(source)
+		widest, _ := g.WidestPath(source)
+		lengths, err := SemiringShortestPaths(&g, source, MinPlusSemiring{}, edgeWeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+		widths, err := SemiringShortestPaths(&g, source, MaxMinSemiring{}, edgeWeight)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for v := range g.vertices {
+			if lengths[v] != shortest[v].Distance {
+				t.Fatalf("%v: length from %v to %v: %v != %v", g, source, v, lengths[v], shortest[v].Distance)
+			}
+			if widths[v] != widest[v].Distance {
+				t.Fatalf("%v: width from %v to %v: %v != %v", g, source, v, widths[v], widest[v].Distance)
+			}
+		}
+	}
+}
+
+func TestSemiringClosure(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        map[int]map[int]bool
+	}{
+		{
+			description: "directed path",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}),
+			want: map[int]map[int]bool{
+				1: {1: true, 2: true, 3: true},
+				2: {1: false, 2: true, 3: true},
+				3: {1: false, 2: false, 3: true},
+			},
+		},
+		{
+			description: "undirected components",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{3, 3}),
+			want: map[int]map[int]bool{
+				1: {1: true, 2: true, 3: false},
+				2: {1: true, 2: true, 3: false},
+				3: {1: false, 2: false, 3: true},
+			},
+		},
+		{
+			description: "directed cycle",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 1}, [2]int{3, 1}),
+			want: map[int]map[int]bool{
+				1: {1: true, 2: true, 3: false},
+				2: {1: true, 2: true, 3: false},
+				3: {1: true, 2: true, 3: true},
+			},
+		},
+		{
+			description: "empty",
+			input:       NewGraph[int](true),
+			want:        map[int]map[int]bool{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := SemiringClosure(&test.input, BooleanSemiring{}, edgeExists)
+
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("%v != %v", got, test.want)
+			}
+		})
+	}
+
+	t.Run("counting on a cycle", func(t *testing.T) {
+		g := edgeListGraph(true, [2]int{1, 2}, [2]int{2, 1})
+		_, err := SemiringClosure(&g, CountingSemiring{}, edgeCount)
+		if !cmp.Equal(err, ConvergenceErr{2}, cmpopts.EquateErrors()) {
+			t.Errorf("%#v != %#v", err, ConvergenceErr{2})
+		}
+	})
+}
@@ -39,52 +39,11 @@ func (g *Graph[V]) Dijkstra(source V) (map[V]PathResult[V], error) {
 		return nil, &MissingVertexErr[V]{v: source}
 	}
 
-	dist := make(map[V]float64)
-	prev := make(map[V]*V)
-	visited := make(map[V]bool)
-
-	// Initialize distances
-	for v := range g.vertices {
-		dist[v] = math.Inf(1)
-	}
-	dist[source] = 0
-
-	// Priority queue
-	pq := adt.NewPriorityQueue[V](0)
-	pq.Push(source, 0)
-
-	for pq.Len() > 0 {
-		u := pq.Pop()
-		if u == nil {
-			break
-		}
-
-		if visited[*u] {
-			continue
-		}
-		visited[*u] = true
-
-		// Get neighbors based on graph type
-		neighbors := g.adjacencyMap[*u].Explicit
-		for v, weight := range neighbors {
-			alt := dist[*u] + weight
-			if alt < dist[v] {
-				dist[v] = alt
-				uCopy := *u
-				prev[v] = &uCopy
-				pq.Push(v, alt)
-			}
-		}
-	}
+	tree := g.dijkstraSearch(source, nil, nil, nil)
 
-	// Build results
 	results := make(map[V]PathResult[V])
 	for v := range g.vertices {
-		path := g.reconstructPath(prev, source, v)
-		results[v] = PathResult[V]{
-			Distance: dist[v],
-			Path:     path,
-		}
+		results[v] = tree.PathResult(v)
 	}
 
 	return results, nil
@@ -109,9 +68,33 @@ func (g *Graph[V]) BellmanFord(source V) (map[V]PathResult[V], error) {
 	}
 	dist[source] = 0
 
+	if err := g.relaxEdges(dist, prev); err != nil {
+		return nil, err
+	}
+
+	// Build results
+	results := make(map[V]PathResult[V])
+	for v := range g.vertices {
+		path := g.reconstructPath(prev, source, v)
+		results[v] = PathResult[V]{
+			Distance: dist[v],
+			Path:     path,
+		}
+	}
+
+	return results, nil
+}
+
+// relaxEdges runs the relaxation rounds of the Bellman-Ford algorithm on
+// dist, recording predecessors in prev, until no distance changes or |V| - 1
+// rounds have passed. If an edge can still be relaxed after that, the graph
+// has a negative cycle reachable from a vertex with a finite distance, and it
+// returns NegativeCycleErr.
+func (g *Graph[V]) relaxEdges(dist map[V]float64, prev map[V]*V) error {
 	// Relax edges |V| - 1 times
 	numVertices := len(g.vertices)
 	for i := 0; i < numVertices-1; i++ {
+		changed := false
 		for u := range g.vertices {
 			if dist[u] == math.Inf(1) {
 				continue
@@ -123,9 +106,13 @@ func (g *Graph[V]) BellmanFord(source V) (map[V]PathResult[V], error) {
 					dist[v] = alt
 					uCopy := u
 					prev[v] = &uCopy
+					changed = true
 				}
 			}
 		}
+		if !changed {
+			return nil
+		}
 	}
 
 	// Check for negative cycles
@@ -138,22 +125,12 @@ func (g *Graph[V]) BellmanFord(source V) (map[V]PathResult[V], error) {
 			if dist[u]+weight < dist[v] {
 				// Negative cycle detected, reconstruct it
 				cycle := g.findNegativeCycle(prev, v)
-				return nil, &NegativeCycleErr[V]{cycle: cycle}
+				return &NegativeCycleErr[V]{cycle: cycle}
 			}
 		}
 	}
 
-	// Build results
-	results := make(map[V]PathResult[V])
-	for v := range g.vertices {
-		path := g.reconstructPath(prev, source, v)
-		results[v] = PathResult[V]{
-			Distance: dist[v],
-			Path:     path,
-		}
-	}
-
-	return results, nil
+	return nil
 }
 
 // FloydWarshall computes the shortest paths between all pairs of vertices
@@ -387,7 +364,12 @@ func (g *Graph[V]) reconstructPath(prev map[V]*V, source, target V) []V {
 		}
 		visited[current] = true
 
-		path = append([]V{current}, path...)
+		path = append(path, current)
+	}
+
+	// The path was built from target back to source.
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
 	}
 
 	return path
@@ -1047,3 +1047,30 @@ func TestDijkstraWithStrings(t *testing.T) {
 		t.Errorf("%+v != %+v", got, want)
 	}
 }
+
+func TestDijkstraNegativeCycle(t *testing.T) {
+	// The edge from d back to b would shorten the path to b, which is already
+	// settled. Relaxing it would make the predecessors of b, c and d a cycle
+	// that does not reach a.
+	g := NewGraph[string](true)
+	_ = g.AddEdge("a", "b", 1)
+	_ = g.AddEdge("b", "c", 1)
+	_ = g.AddEdge("c", "d", 1)
+	_ = g.AddEdge("d", "b", -5)
+
+	got, err := g.Dijkstra("a")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]PathResult[string]{
+		"a": {Distance: 0.0, Path: []string{"a"}},
+		"b": {Distance: 1.0, Path: []string{"a", "b"}},
+		"c": {Distance: 2.0, Path: []string{"a", "b", "c"}},
+		"d": {Distance: 3.0, Path: []string{"a", "b", "c", "d"}},
+	}
+
+	if !cmp.Equal(got, want) {
+		t.Errorf("%+v != %+v", got, want)
+	}
+}
@@ -0,0 +1,127 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/subpop/go-adt"
+)
+
+// A ShortestPathTree holds the distances and predecessors found by a
+// single-source shortest path search. Paths are reconstructed on demand from
+// the predecessors, so a search that reaches many vertices costs nothing for
+// the paths that are never asked for.
+type ShortestPathTree[V comparable] struct {
+	source  V
+	dist    map[V]float64
+	prev    map[V]V
+	settled set[V]
+}
+
+// Source returns the vertex the search started from.
+func (t *ShortestPathTree[V]) Source() V {
+	return t.source
+}
+
+// Reached returns true if the search settled v, that is, if it found a
+// shortest path to v.
+func (t *ShortestPathTree[V]) Reached(v V) bool {
+	return t.settled[v]
+}
+
+// Distance returns the length of a shortest path from the source to v, or
+// math.Inf(1) if the search did not reach v.
+func (t *ShortestPathTree[V]) Distance(v V) float64 {
+	if !t.settled[v] {
+		return math.Inf(1)
+	}
+
+	return t.dist[v]
+}
+
+// Path returns a shortest path from the source to v, or nil if the search did
+// not reach v.
+func (t *ShortestPathTree[V]) Path(v V) []V {
+	if !t.settled[v] {
+		return nil
+	}
+
+	path := []V{v}
+	for v != t.source {
+		v = t.prev[v]
+		path = append(path, v)
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+
+	return path
+}
+
+// PathResult returns the distance and path from the source to v, in the same
+// form as the entries returned by Dijkstra.
+func (t *ShortestPathTree[V]) PathResult(v V) PathResult[V] {
+	return PathResult[V]{
+		Distance: t.Distance(v),
+		Path:     t.Path(v),
+	}
+}
+
+// ShortestPath runs Dijkstra's algorithm from source and stops as soon as
+// target is settled, instead of settling every reachable vertex. The returned
+// tree answers queries for target and for every vertex settled before it,
+// that is, every vertex closer to source than target; other vertices are
+// reported as not reached. Paths are only reconstructed when asked for. Like
+// Dijkstra, it only works correctly with non-negative edge weights. If source
+// or target do not exist in the graph, it returns MissingVertexErr.
+func (g *Graph[V]) ShortestPath(source, target V) (*ShortestPathTree[V], error) {
+	if _, ok := g.vertices[source]; !ok {
+		return nil, &MissingVertexErr[V]{v: source}
+	}
+	if _, ok := g.vertices[target]; !ok {
+		return nil, &MissingVertexErr[V]{v: target}
+	}
+
+	return g.dijkstraSearch(source, &target, nil, nil), nil
+}
+
+// dijkstraSearch runs Dijkstra's algorithm from source, stopping once target
+// is settled if target is not nil. Vertices in skipVertices and edges in
+// skipEdges (keyed by edgeKey) are treated as absent.
+func (g *Graph[V]) dijkstraSearch(source V, target *V, skipVertices set[V], skipEdges map[Edge[V]]bool) *ShortestPathTree[V] {
+	t := &ShortestPathTree[V]{
+		source:  source,
+		dist:    map[V]float64{source: 0},
+		prev:    make(map[V]V),
+		settled: make(set[V]),
+	}
+
+	pq := adt.NewPriorityQueue[V](0)
+	pq.Push(source, 0)
+	for pq.Len() > 0 {
+		u := *pq.Pop()
+		if t.settled[u] {
+			continue
+		}
+		t.settled[u] = true
+		if target != nil && u == *target {
+			break
+		}
+
+		// Settled vertices are never relaxed again, which keeps the
+		// predecessors a tree rooted at source even if a negative edge
+		// weight would shorten a path to one of them.
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			if t.settled[v] || skipVertices[v] || (skipEdges != nil && skipEdges[g.edgeKey(u, v)]) {
+				continue
+			}
+			alt := t.dist[u] + weight
+			if d, ok := t.dist[v]; !ok || alt < d {
+				t.dist[v] = alt
+				t.prev[v] = u
+				pq.Push(v, alt)
+			}
+		}
+	}
+
+	return t
+}
@@ -0,0 +1,124 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestShortestPath(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[string]
+		source      string
+		target      string
+		want        PathResult[string]
+		wantError   error
+	}{
+		{
+			description: "directed",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "H",
+			want:        PathResult[string]{Distance: 5, Path: []string{"C", "E", "F", "H"}},
+		},
+		{
+			description: "source is target",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "C",
+			want:        PathResult[string]{Distance: 0, Path: []string{"C"}},
+		},
+		{
+			description: "unreachable",
+			input:       yenGraph(),
+			source:      "H",
+			target:      "C",
+			want:        PathResult[string]{Distance: math.Inf(1)},
+		},
+		{
+			description: "missing source",
+			input:       yenGraph(),
+			source:      "Z",
+			target:      "C",
+			wantError:   &MissingVertexErr[string]{},
+		},
+		{
+			description: "missing target",
+			input:       yenGraph(),
+			source:      "C",
+			target:      "Z",
+			wantError:   &MissingVertexErr[string]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.ShortestPath(test.source, test.target)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if got.Source() != test.source {
+					t.Errorf("%v != %v", got.Source(), test.source)
+				}
+				if !cmp.Equal(got.PathResult(test.target), test.want) {
+					t.Errorf("%v != %v", got.PathResult(test.target), test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestShortestPathStopsAtTarget(t *testing.T) {
+	g := weightedPath(6)
+
+	got, err := g.ShortestPath(0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for v, want := range map[int]bool{0: true, 1: true, 2: true, 3: false, 4: false, 5: false} {
+		if got.Reached(v) != want {
+			t.Errorf("Reached(%v): %v != %v", v, got.Reached(v), want)
+		}
+	}
+	if !math.IsInf(got.Distance(5), 1) || got.Path(5) != nil {
+		t.Errorf("%v, %v != +Inf, nil", got.Distance(5), got.Path(5))
+	}
+	if !cmp.Equal(got.Path(1), []int{0, 1}) {
+		t.Errorf("%v != %v", got.Path(1), []int{0, 1})
+	}
+}
+
+func TestShortestPathRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		g := randomWeightedGraph(r, i%2 == 0, 12, 0.2)
+		source, target := r.Intn(12), r.Intn(12)
+
+		want, _ := g.Dijkstra(source)
+		tree, err := g.ShortestPath(source, target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := tree.PathResult(target)
+
+		if got.Distance != want[target].Distance {
+			t.Fatalf("%v: %v to %v: %v != %v", g, source, target, got.Distance, want[target].Distance)
+		}
+		if !math.IsInf(got.Distance, 1) {
+			if !isPath(g, got.Path, source, target) || g.pathWeight(got.Path) != got.Distance {
+				t.Errorf("%v: %v is not a path of weight %v from %v to %v", g, got.Path, got.Distance, source, target)
+			}
+		}
+	}
+}
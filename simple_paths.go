@@ -0,0 +1,116 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/subpop/go-adt"
+)
+
+// AllSimplePaths returns an iterator over the simple paths from source to
+// target, that is, the paths that visit no vertex twice, with at most maxLen
+// edges. A negative maxLen places no limit on the length. In a directed graph,
+// paths follow outbound edges. Paths are produced lazily by a depth-first
+// search that visits neighbors in the order of their string representation,
+// and each yielded slice is a new copy that the caller may keep. Vertices that
+// cannot reach target within the remaining length are never explored, but the
+// number of paths can still grow exponentially with the size of the graph. If
+// source equals target, the only path is the one containing just source. If
+// the graph does not contain source or target, it returns MissingVertexErr.
+func (g *Graph[V]) AllSimplePaths(source, target V, maxLen int) (func(yield func([]V) bool), error) {
+	if _, ok := g.vertices[source]; !ok {
+		return nil, &MissingVertexErr[V]{v: source}
+	}
+	if _, ok := g.vertices[target]; !ok {
+		return nil, &MissingVertexErr[V]{v: target}
+	}
+
+	return func(yield func([]V) bool) {
+		remaining := g.hopsTo(target)
+		if d, ok := remaining[source]; !ok || (maxLen >= 0 && d > maxLen) {
+			return
+		}
+		if source == target {
+			yield([]V{source})
+			return
+		}
+
+		neighbors := make(map[V][]V)
+		path := []V{source}
+		onPath := set[V]{source: true}
+		next := []int{0}
+		for len(path) > 0 {
+			u := path[len(path)-1]
+			if _, ok := neighbors[u]; !ok {
+				neighbors[u] = g.sortedNeighbors(u)
+			}
+			if next[len(next)-1] == len(neighbors[u]) {
+				path = path[:len(path)-1]
+				next = next[:len(next)-1]
+				delete(onPath, u)
+				continue
+			}
+			v := neighbors[u][next[len(next)-1]]
+			next[len(next)-1]++
+
+			// Extending the path to v uses len(path) edges, and at least
+			// remaining[v] more are needed to reach target from there.
+			d, ok := remaining[v]
+			if !ok || onPath[v] || (maxLen >= 0 && len(path)+d > maxLen) {
+				continue
+			}
+			if v == target {
+				p := make([]V, len(path)+1)
+				copy(p, path)
+				p[len(path)] = v
+				if !yield(p) {
+					return
+				}
+				continue
+			}
+			path = append(path, v)
+			onPath[v] = true
+			next = append(next, 0)
+		}
+	}, nil
+}
+
+// hopsTo returns the number of edges on a shortest path from every vertex
+// that can reach target to target. Vertices that cannot reach target are
+// absent from the result.
+func (g *Graph[V]) hopsTo(target V) map[V]int {
+	hops := map[V]int{target: 0}
+	queue := adt.NewQueue[V]()
+	_ = queue.Enqueue(target)
+	for queue.Len() > 0 {
+		v := *queue.Dequeue()
+		predecessors := g.adjacencyMap[v].Explicit
+		if g.isDirected {
+			predecessors = g.adjacencyMap[v].Implicit
+		}
+		for u := range predecessors {
+			if _, ok := hops[u]; !ok {
+				hops[u] = hops[v] + 1
+				_ = queue.Enqueue(u)
+			}
+		}
+	}
+
+	return hops
+}
+
+// sortedNeighbors returns the vertices that v has an outbound edge to (any
+// edge, in an undirected graph), ordered by their string representation.
+func (g *Graph[V]) sortedNeighbors(v V) []V {
+	neighbors := make([]V, 0, len(g.adjacencyMap[v].Explicit))
+	keys := make(map[V]string, len(g.adjacencyMap[v].Explicit))
+	for u := range g.adjacencyMap[v].Explicit {
+		neighbors = append(neighbors, u)
+		keys[u] = fmt.Sprintf("%v", u)
+	}
+	sort.Slice(neighbors, func(i, j int) bool {
+		return keys[neighbors[i]] < keys[neighbors[j]]
+	})
+
+	return neighbors
+}
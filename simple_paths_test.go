@@ -0,0 +1,145 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestAllSimplePaths(t *testing.T) {
+	diamond := edgeListGraph(true, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 3}, [2]int{2, 4}, [2]int{3, 4})
+	triangle := edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{3, 4})
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		source      int
+		target      int
+		maxLen      int
+		limit       int
+		want        [][]int
+		wantError   error
+	}{
+		{
+			description: "directed",
+			input:       diamond,
+			source:      1,
+			target:      4,
+			maxLen:      -1,
+			want:        [][]int{{1, 2, 3, 4}, {1, 2, 4}, {1, 3, 4}},
+		},
+		{
+			description: "length limit",
+			input:       diamond,
+			source:      1,
+			target:      4,
+			maxLen:      2,
+			want:        [][]int{{1, 2, 4}, {1, 3, 4}},
+		},
+		{
+			description: "stop early",
+			input:       diamond,
+			source:      1,
+			target:      4,
+			maxLen:      -1,
+			limit:       1,
+			want:        [][]int{{1, 2, 3, 4}},
+		},
+		{
+			description: "undirected",
+			input:       triangle,
+			source:      1,
+			target:      4,
+			maxLen:      -1,
+			want:        [][]int{{1, 2, 3, 4}, {1, 3, 4}},
+		},
+		{
+			description: "source is target",
+			input:       triangle,
+			source:      1,
+			target:      1,
+			maxLen:      -1,
+			want:        [][]int{{1}},
+		},
+		{
+			description: "unreachable",
+			input:       diamond,
+			source:      4,
+			target:      1,
+			maxLen:      -1,
+			want:        [][]int{},
+		},
+		{
+			description: "too short",
+			input:       diamond,
+			source:      1,
+			target:      4,
+			maxLen:      1,
+			want:        [][]int{},
+		},
+		{
+			description: "missing vertex",
+			input:       diamond,
+			source:      1,
+			target:      5,
+			wantError:   &MissingVertexErr[int]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			paths, err := test.input.AllSimplePaths(test.source, test.target, test.maxLen)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				got := make([][]int, 0)
+				paths(func(path []int) bool {
+					got = append(got, path)
+					return test.limit == 0 || len(got) < test.limit
+				})
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAllSimplePathsComplete(t *testing.T) {
+	g := NewGraph[int](false)
+	for i := 0; i < 5; i++ {
+		for j := i + 1; j < 5; j++ {
+			_ = g.AddEdge(i, j, 1)
+		}
+	}
+
+	paths, err := g.AllSimplePaths(0, 4, -1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A simple path from 0 to 4 passes through an ordered selection of the
+	// other three vertices: 1 + 3 + 3·2 + 3·2·1 = 16 paths.
+	count := 0
+	paths(func(path []int) bool {
+		count++
+		seen := make(map[int]bool)
+		for _, v := range path {
+			if seen[v] {
+				t.Errorf("path %v is not simple", path)
+			}
+			seen[v] = true
+		}
+		return true
+	})
+	if count != 16 {
+		t.Errorf("%v != %v", count, 16)
+	}
+}
@@ -0,0 +1,125 @@
+package graph
+
+import (
+	"math"
+
+	"github.com/subpop/go-adt"
+)
+
+// GraphStats summarizes the size and shape of a Graph, as returned by Stats.
+type GraphStats struct {
+	// Vertices and Edges count the vertices and edges of the graph. Each
+	// undirected edge, including a self-loop, counts once.
+	Vertices, Edges int
+
+	// Density is the number of edges between distinct vertices divided by the
+	// number of possible such edges: n(n-1)/2 in an undirected graph and
+	// n(n-1) in a directed graph. It is 0 in graphs with fewer than two
+	// vertices.
+	Density float64
+
+	// MinDegree, MaxDegree and MeanDegree describe the degrees of the
+	// vertices, and DegreeHistogram maps each degree to the number of
+	// vertices that have it. In an undirected graph the degree of a vertex is
+	// as reported by Degree; in a directed graph it is the sum of its in- and
+	// out-degree. All are zero in an empty graph.
+	MinDegree, MaxDegree int
+	MeanDegree           float64
+	DegreeHistogram      map[int]int
+
+	// Components is the number of connected components, ignoring edge
+	// direction.
+	Components int
+
+	// SelfLoops counts the edges from a vertex to itself.
+	SelfLoops int
+
+	// IsDAG is true if the graph is directed and has no cycles.
+	IsDAG bool
+
+	// MinWeight and MaxWeight are the smallest and largest edge weights. Both
+	// are 0 in a graph without edges.
+	MinWeight, MaxWeight float64
+}
+
+// Stats returns summary statistics of the graph. The edges are scanned once,
+// collecting counts, degrees, weights and components together; only the
+// acyclicity check of a directed graph walks the graph a second time.
+func (g *Graph[V]) Stats() GraphStats {
+	stats := GraphStats{
+		Vertices:        len(g.vertices),
+		DegreeHistogram: make(map[int]int),
+		MinWeight:       math.Inf(1),
+		MaxWeight:       math.Inf(-1),
+	}
+
+	sets := make(map[V]*adt.DisjointSet[V], len(g.vertices))
+	for v := range g.vertices {
+		sets[v] = adt.NewDisjointSet(v)
+	}
+
+	links := 0
+	degrees := make(map[V]int, len(g.vertices))
+	for u, edges := range g.adjacencyMap {
+		for v, weight := range edges.Explicit {
+			stats.MinWeight = math.Min(stats.MinWeight, weight)
+			stats.MaxWeight = math.Max(stats.MaxWeight, weight)
+
+			if u == v {
+				stats.SelfLoops++
+			} else {
+				links++
+			}
+			degrees[u]++
+			if g.isDirected {
+				degrees[v]++
+			}
+			if sets[u].Find() != sets[v].Find() {
+				adt.Union(sets[u], sets[v])
+			}
+		}
+	}
+
+	// An undirected edge between distinct vertices is stored at both ends.
+	if !g.isDirected {
+		links /= 2
+	}
+	stats.Edges = links + stats.SelfLoops
+	if stats.Edges == 0 {
+		stats.MinWeight, stats.MaxWeight = 0, 0
+	}
+
+	if n := len(g.vertices); n > 1 {
+		pairs := n * (n - 1)
+		if !g.isDirected {
+			pairs /= 2
+		}
+		stats.Density = float64(links) / float64(pairs)
+	}
+
+	roots := make(map[*adt.DisjointSet[V]]bool)
+	total := 0
+	first := true
+	for v := range g.vertices {
+		roots[sets[v].Find()] = true
+
+		d := degrees[v]
+		stats.DegreeHistogram[d]++
+		total += d
+		if first || d < stats.MinDegree {
+			stats.MinDegree = d
+		}
+		if first || d > stats.MaxDegree {
+			stats.MaxDegree = d
+		}
+		first = false
+	}
+	stats.Components = len(roots)
+	if len(g.vertices) > 0 {
+		stats.MeanDegree = float64(total) / float64(len(g.vertices))
+	}
+
+	stats.IsDAG = g.isDirected && !g.HasCycle()
+
+	return stats
+}
@@ -0,0 +1,87 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestStats(t *testing.T) {
+	mixed := NewGraph[int](false)
+	_ = mixed.AddEdge(1, 2, 2)
+	_ = mixed.AddEdge(2, 3, -1)
+	_ = mixed.AddEdge(3, 3, 5)
+	_ = mixed.AddVertex(4)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		want        GraphStats
+	}{
+		{
+			description: "empty",
+			input:       NewGraph[int](false),
+			want:        GraphStats{DegreeHistogram: map[int]int{}},
+		},
+		{
+			description: "undirected with self-loop and isolated vertex",
+			input:       mixed,
+			want: GraphStats{
+				Vertices:        4,
+				Edges:           3,
+				Density:         2.0 / 6,
+				MinDegree:       0,
+				MaxDegree:       2,
+				MeanDegree:      5.0 / 4,
+				DegreeHistogram: map[int]int{0: 1, 1: 1, 2: 2},
+				Components:      2,
+				SelfLoops:       1,
+				MinWeight:       -1,
+				MaxWeight:       5,
+			},
+		},
+		{
+			description: "directed acyclic",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{1, 3}, [2]int{2, 3}),
+			want: GraphStats{
+				Vertices:        3,
+				Edges:           3,
+				Density:         0.5,
+				MinDegree:       2,
+				MaxDegree:       2,
+				MeanDegree:      2,
+				DegreeHistogram: map[int]int{2: 3},
+				Components:      1,
+				IsDAG:           true,
+				MinWeight:       1,
+				MaxWeight:       1,
+			},
+		},
+		{
+			description: "directed cycle and isolated arc",
+			input:       edgeListGraph(true, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}, [2]int{4, 5}),
+			want: GraphStats{
+				Vertices:        5,
+				Edges:           4,
+				Density:         0.2,
+				MinDegree:       1,
+				MaxDegree:       2,
+				MeanDegree:      8.0 / 5,
+				DegreeHistogram: map[int]int{1: 2, 2: 3},
+				Components:      2,
+				MinWeight:       1,
+				MaxWeight:       1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got := test.input.Stats()
+
+			if !cmp.Equal(got, test.want) {
+				t.Errorf("%+v != %+v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,465 @@
+package graph
+
+import (
+	"fmt"
+	"sort"
+)
+
+// SolverMode selects how an NP-hard optimization problem is solved.
+type SolverMode int
+
+const (
+	// ExactSolver finds an optimal solution by branch-and-bound. Its running
+	// time is exponential in the worst case, so it is only suitable for small
+	// graphs.
+	ExactSolver SolverMode = iota
+
+	// ApproximateSolver finds a solution in polynomial time whose size is
+	// within a known factor of the optimum.
+	ApproximateSolver
+)
+
+// MaximumIndependentSet returns a set of pairwise non-adjacent vertices of an
+// undirected graph. With ExactSolver the set is as large as possible. With
+// ApproximateSolver, vertices of minimum remaining degree are chosen greedily,
+// which is within a factor of (Δ+2)/3 of the maximum. A vertex with a self-loop
+// is adjacent to itself and is never included. If the graph is directed, it
+// returns DirectedGraphErr.
+func (g *Graph[V]) MaximumIndependentSet(mode SolverMode) ([]V, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	s := newVertexSetSolver(g)
+	switch mode {
+	case ExactSolver:
+		return s.vertices(s.exactIndependentSet()), nil
+	case ApproximateSolver:
+		return s.vertices(s.greedyIndependentSet()), nil
+	default:
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", mode), "unknown solver mode"}
+	}
+}
+
+// MinimumVertexCover returns a set of vertices of an undirected graph that
+// touches every edge. On bipartite graphs the cover is always minimum; it is
+// derived from a maximum matching by König's theorem in polynomial time. On
+// other graphs, ExactSolver returns a minimum cover as the complement of a
+// maximum independent set, and ApproximateSolver returns both endpoints of a
+// maximal matching, which is at most twice the minimum size. If the graph is
+// directed, it returns DirectedGraphErr.
+func (g *Graph[V]) MinimumVertexCover(mode SolverMode) ([]V, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	if mode != ExactSolver && mode != ApproximateSolver {
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", mode), "unknown solver mode"}
+	}
+
+	s := newVertexSetSolver(g)
+	if side, ok := g.bipartition(); ok && !s.hasSelfLoop() {
+		return s.vertices(s.konigVertexCover(side)), nil
+	}
+
+	if mode == ApproximateSolver {
+		return s.vertices(s.matchingVertexCover()), nil
+	}
+
+	independent := make([]bool, len(s.vertexList))
+	for _, v := range s.exactIndependentSet() {
+		independent[v] = true
+	}
+	cover := make([]int, 0, len(s.vertexList))
+	for v := range s.vertexList {
+		if !independent[v] {
+			cover = append(cover, v)
+		}
+	}
+
+	return s.vertices(cover), nil
+}
+
+// MinimumDominatingSet returns a set of vertices of an undirected graph such
+// that every vertex is either in the set or adjacent to a vertex in the set.
+// With ExactSolver the set is as small as possible. With ApproximateSolver,
+// the vertex dominating the most undominated vertices is chosen repeatedly,
+// which is within a factor of ln(Δ+1)+1 of the minimum. If the graph is
+// directed, it returns DirectedGraphErr.
+func (g *Graph[V]) MinimumDominatingSet(mode SolverMode) ([]V, error) {
+	if g.isDirected {
+		return nil, DirectedGraphErr{}
+	}
+
+	s := newVertexSetSolver(g)
+	switch mode {
+	case ExactSolver:
+		return s.vertices(s.exactDominatingSet()), nil
+	case ApproximateSolver:
+		return s.vertices(s.greedyDominatingSet()), nil
+	default:
+		return nil, InvalidArgumentErr{fmt.Sprintf("%v", mode), "unknown solver mode"}
+	}
+}
+
+// vertexSetSolver indexes the vertices of an undirected graph so that the
+// solvers can work on slices. adj holds the neighbors of each vertex without
+// self-loops, and loop records which vertices have a self-loop.
+type vertexSetSolver[V comparable] struct {
+	vertexList []V
+	adj        [][]int
+	loop       []bool
+}
+
+func newVertexSetSolver[V comparable](g *Graph[V]) *vertexSetSolver[V] {
+	s := &vertexSetSolver[V]{vertexList: g.GetAllVertices()}
+
+	index := make(map[V]int, len(s.vertexList))
+	for i, v := range s.vertexList {
+		index[v] = i
+	}
+
+	s.adj = make([][]int, len(s.vertexList))
+	s.loop = make([]bool, len(s.vertexList))
+	for i, v := range s.vertexList {
+		for n := range g.adjacencyMap[v].Explicit {
+			if n == v {
+				s.loop[i] = true
+			} else {
+				s.adj[i] = append(s.adj[i], index[n])
+			}
+		}
+	}
+
+	return s
+}
+
+func (s *vertexSetSolver[V]) vertices(indices []int) []V {
+	vertices := make([]V, len(indices))
+	for i, v := range indices {
+		vertices[i] = s.vertexList[v]
+	}
+	return vertices
+}
+
+func (s *vertexSetSolver[V]) hasSelfLoop() bool {
+	for _, loop := range s.loop {
+		if loop {
+			return true
+		}
+	}
+	return false
+}
+
+// candidates returns the vertices that may join an independent set: every
+// vertex without a self-loop.
+func (s *vertexSetSolver[V]) candidates() ([]bool, int) {
+	alive := make([]bool, len(s.vertexList))
+	count := 0
+	for v := range alive {
+		if !s.loop[v] {
+			alive[v] = true
+			count++
+		}
+	}
+	return alive, count
+}
+
+// greedyIndependentSet repeatedly takes a vertex of minimum remaining degree
+// and removes it along with its neighbors.
+func (s *vertexSetSolver[V]) greedyIndependentSet() []int {
+	alive, count := s.candidates()
+	result := make([]int, 0)
+	for count > 0 {
+		v, _ := s.minDegree(alive)
+		result = append(result, v)
+		count -= s.removeClosedNeighborhood(alive, v)
+	}
+	return result
+}
+
+// exactIndependentSet finds a maximum independent set by branch-and-bound,
+// starting from the greedy solution as the incumbent.
+func (s *vertexSetSolver[V]) exactIndependentSet() []int {
+	best := s.greedyIndependentSet()
+	alive, count := s.candidates()
+	s.branchIndependentSet(alive, count, make([]int, 0), &best)
+	return best
+}
+
+func (s *vertexSetSolver[V]) branchIndependentSet(alive []bool, count int, current []int, best *[]int) {
+	if len(current)+count <= len(*best) {
+		return
+	}
+	if count == 0 {
+		*best = append([]int(nil), current...)
+		return
+	}
+
+	// A vertex of degree zero or one always belongs to some maximum
+	// independent set, so it can be taken without branching.
+	if v, degree := s.minDegree(alive); degree <= 1 {
+		next := append([]bool(nil), alive...)
+		removed := s.removeClosedNeighborhood(next, v)
+		s.branchIndependentSet(next, count-removed, append(current, v), best)
+		return
+	}
+
+	// Otherwise branch on a vertex of maximum degree: either it is in the set
+	// and its neighbors are not, or it is not in the set.
+	v, _ := s.maxDegree(alive)
+
+	with := append([]bool(nil), alive...)
+	removed := s.removeClosedNeighborhood(with, v)
+	s.branchIndependentSet(with, count-removed, append(current, v), best)
+
+	without := append([]bool(nil), alive...)
+	without[v] = false
+	s.branchIndependentSet(without, count-1, current, best)
+}
+
+func (s *vertexSetSolver[V]) degree(alive []bool, v int) int {
+	degree := 0
+	for _, n := range s.adj[v] {
+		if alive[n] {
+			degree++
+		}
+	}
+	return degree
+}
+
+func (s *vertexSetSolver[V]) minDegree(alive []bool) (int, int) {
+	best, bestDegree := -1, -1
+	for v := range alive {
+		if !alive[v] {
+			continue
+		}
+		if d := s.degree(alive, v); best < 0 || d < bestDegree {
+			best, bestDegree = v, d
+		}
+	}
+	return best, bestDegree
+}
+
+func (s *vertexSetSolver[V]) maxDegree(alive []bool) (int, int) {
+	best, bestDegree := -1, -1
+	for v := range alive {
+		if !alive[v] {
+			continue
+		}
+		if d := s.degree(alive, v); d > bestDegree {
+			best, bestDegree = v, d
+		}
+	}
+	return best, bestDegree
+}
+
+// removeClosedNeighborhood marks v and its neighbors as no longer alive and
+// returns how many vertices were removed.
+func (s *vertexSetSolver[V]) removeClosedNeighborhood(alive []bool, v int) int {
+	removed := 0
+	if alive[v] {
+		alive[v] = false
+		removed++
+	}
+	for _, n := range s.adj[v] {
+		if alive[n] {
+			alive[n] = false
+			removed++
+		}
+	}
+	return removed
+}
+
+// matchingVertexCover takes both endpoints of every edge in a greedily built
+// maximal matching, plus every vertex with a self-loop.
+func (s *vertexSetSolver[V]) matchingVertexCover() []int {
+	covered := make([]bool, len(s.vertexList))
+	cover := make([]int, 0)
+	for v := range s.vertexList {
+		if s.loop[v] && !covered[v] {
+			covered[v] = true
+			cover = append(cover, v)
+		}
+	}
+	for u := range s.vertexList {
+		if covered[u] {
+			continue
+		}
+		for _, v := range s.adj[u] {
+			if !covered[v] {
+				covered[u], covered[v] = true, true
+				cover = append(cover, u, v)
+				break
+			}
+		}
+	}
+	return cover
+}
+
+// konigVertexCover computes a minimum vertex cover of a bipartite graph. It
+// finds a maximum matching with augmenting paths, collects the vertices
+// reachable from unmatched left vertices by alternating paths, and returns the
+// left vertices that are not reachable together with the right vertices that
+// are.
+func (s *vertexSetSolver[V]) konigVertexCover(side map[V]bool) []int {
+	n := len(s.vertexList)
+	left := make([]bool, n)
+	for v := range s.vertexList {
+		left[v] = !side[s.vertexList[v]]
+	}
+
+	match := make([]int, n)
+	for v := range match {
+		match[v] = -1
+	}
+
+	var augment func(u int, seen []bool) bool
+	augment = func(u int, seen []bool) bool {
+		for _, v := range s.adj[u] {
+			if seen[v] {
+				continue
+			}
+			seen[v] = true
+			if match[v] < 0 || augment(match[v], seen) {
+				match[u], match[v] = v, u
+				return true
+			}
+		}
+		return false
+	}
+	for u := range s.vertexList {
+		if left[u] && match[u] < 0 {
+			augment(u, make([]bool, n))
+		}
+	}
+
+	reachable := make([]bool, n)
+	var walk func(u int)
+	walk = func(u int) {
+		reachable[u] = true
+		for _, v := range s.adj[u] {
+			if reachable[v] || match[u] == v {
+				continue
+			}
+			reachable[v] = true
+			if w := match[v]; w >= 0 && !reachable[w] {
+				walk(w)
+			}
+		}
+	}
+	for u := range s.vertexList {
+		if left[u] && match[u] < 0 {
+			walk(u)
+		}
+	}
+
+	cover := make([]int, 0)
+	for v := range s.vertexList {
+		if left[v] != reachable[v] {
+			cover = append(cover, v)
+		}
+	}
+	return cover
+}
+
+// greedyDominatingSet repeatedly takes the vertex that dominates the most
+// vertices that are not yet dominated.
+func (s *vertexSetSolver[V]) greedyDominatingSet() []int {
+	dominated := make([]bool, len(s.vertexList))
+	remaining := len(s.vertexList)
+	result := make([]int, 0)
+	for remaining > 0 {
+		best, bestGain := -1, -1
+		for v := range s.vertexList {
+			if gain := s.gain(dominated, v); gain > bestGain {
+				best, bestGain = v, gain
+			}
+		}
+		result = append(result, best)
+		remaining -= len(s.dominate(dominated, best))
+	}
+	return result
+}
+
+// exactDominatingSet finds a minimum dominating set by branch-and-bound. Each
+// step picks the undominated vertex with the fewest possible dominators and
+// branches on which of them joins the set.
+func (s *vertexSetSolver[V]) exactDominatingSet() []int {
+	best := s.greedyDominatingSet()
+
+	maxCoverage := 1
+	for v := range s.vertexList {
+		if len(s.adj[v])+1 > maxCoverage {
+			maxCoverage = len(s.adj[v]) + 1
+		}
+	}
+
+	dominated := make([]bool, len(s.vertexList))
+	var branch func(remaining int, current []int)
+	branch = func(remaining int, current []int) {
+		if remaining == 0 {
+			if len(current) < len(best) {
+				best = append([]int(nil), current...)
+			}
+			return
+		}
+		// Every additional vertex dominates at most maxCoverage vertices.
+		if len(current)+(remaining+maxCoverage-1)/maxCoverage >= len(best) {
+			return
+		}
+
+		target := -1
+		for v := range s.vertexList {
+			if !dominated[v] && (target < 0 || len(s.adj[v]) < len(s.adj[target])) {
+				target = v
+			}
+		}
+
+		options := append([]int{target}, s.adj[target]...)
+		sort.Slice(options, func(i, j int) bool {
+			return s.gain(dominated, options[i]) > s.gain(dominated, options[j])
+		})
+		for _, v := range options {
+			changed := s.dominate(dominated, v)
+			branch(remaining-len(changed), append(current, v))
+			for _, c := range changed {
+				dominated[c] = false
+			}
+		}
+	}
+	branch(len(s.vertexList), make([]int, 0))
+
+	return best
+}
+
+// gain returns how many undominated vertices v would dominate.
+func (s *vertexSetSolver[V]) gain(dominated []bool, v int) int {
+	gain := 0
+	if !dominated[v] {
+		gain++
+	}
+	for _, n := range s.adj[v] {
+		if !dominated[n] {
+			gain++
+		}
+	}
+	return gain
+}
+
+// dominate marks v and its neighbors as dominated and returns the vertices
+// that were not dominated before.
+func (s *vertexSetSolver[V]) dominate(dominated []bool, v int) []int {
+	changed := make([]int, 0)
+	if !dominated[v] {
+		dominated[v] = true
+		changed = append(changed, v)
+	}
+	for _, n := range s.adj[v] {
+		if !dominated[n] {
+			dominated[n] = true
+			changed = append(changed, n)
+		}
+	}
+	return changed
+}
@@ -0,0 +1,305 @@
+package graph
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func isIndependentSet[V comparable](g Graph[V], vertices []V) bool {
+	in := make(map[V]bool)
+	for _, v := range vertices {
+		if in[v] {
+			return false
+		}
+		in[v] = true
+	}
+	for _, v := range vertices {
+		for n := range g.adjacencyMap[v].Explicit {
+			if in[n] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isVertexCover[V comparable](g Graph[V], vertices []V) bool {
+	in := make(map[V]bool)
+	for _, v := range vertices {
+		in[v] = true
+	}
+	for u := range g.vertices {
+		for v := range g.adjacencyMap[u].Explicit {
+			if !in[u] && !in[v] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isDominatingSet[V comparable](g Graph[V], vertices []V) bool {
+	dominated := make(map[V]bool)
+	for _, v := range vertices {
+		dominated[v] = true
+		for n := range g.adjacencyMap[v].Explicit {
+			dominated[n] = true
+		}
+	}
+	return len(dominated) == g.NumVertex()
+}
+
+// bruteForceMinimum returns the size of the smallest subset of the vertices
+// 0..n-1 that satisfies ok.
+func bruteForceMinimum(n int, ok func([]int) bool) int {
+	best := n
+	for mask := 0; mask < 1<<n; mask++ {
+		subset := make([]int, 0)
+		for v := 0; v < n; v++ {
+			if mask&(1<<v) != 0 {
+				subset = append(subset, v)
+			}
+		}
+		if len(subset) < best && ok(subset) {
+			best = len(subset)
+		}
+	}
+	return best
+}
+
+func TestMaximumIndependentSet(t *testing.T) {
+	petersen := edgeListGraph(false,
+		[2]int{0, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 0},
+		[2]int{0, 5}, [2]int{1, 6}, [2]int{2, 7}, [2]int{3, 8}, [2]int{4, 9},
+		[2]int{5, 7}, [2]int{7, 9}, [2]int{9, 6}, [2]int{6, 8}, [2]int{8, 5},
+	)
+
+	tests := []struct {
+		description string
+		input       Graph[int]
+		mode        SolverMode
+		wantSize    int
+		wantError   error
+	}{
+		{
+			description: "petersen graph exact",
+			input:       petersen,
+			mode:        ExactSolver,
+			wantSize:    4,
+		},
+		{
+			description: "star approximate",
+			input:       edgeListGraph(false, [2]int{0, 1}, [2]int{0, 2}, [2]int{0, 3}),
+			mode:        ApproximateSolver,
+			wantSize:    3,
+		},
+		{
+			description: "self-loop excluded",
+			input:       edgeListGraph(false, [2]int{1, 1}, [2]int{1, 2}, [2]int{2, 3}),
+			mode:        ExactSolver,
+			wantSize:    1,
+		},
+		{
+			description: "unknown mode",
+			input:       petersen,
+			mode:        SolverMode(7),
+			wantError:   InvalidArgumentErr{"7", "unknown solver mode"},
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			mode:        ExactSolver,
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.MaximumIndependentSet(test.mode)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !isIndependentSet(test.input, got) {
+					t.Errorf("not an independent set: %v", got)
+				}
+				if len(got) != test.wantSize {
+					t.Errorf("%v != %v", len(got), test.wantSize)
+				}
+			}
+		})
+	}
+}
+
+func TestMinimumVertexCover(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		mode        SolverMode
+		wantSize    int
+		wantError   error
+	}{
+		{
+			description: "bipartite graph approximate mode is exact",
+			input: edgeListGraph(false,
+				[2]int{1, 4}, [2]int{1, 5}, [2]int{2, 4}, [2]int{3, 4}, [2]int{3, 6}, [2]int{7, 6},
+			),
+			mode:     ApproximateSolver,
+			wantSize: 3,
+		},
+		{
+			description: "odd cycle exact",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{5, 1}),
+			mode:        ExactSolver,
+			wantSize:    3,
+		},
+		{
+			description: "triangle approximate",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1}),
+			mode:        ApproximateSolver,
+			wantSize:    2,
+		},
+		{
+			description: "self-loop forces vertex",
+			input:       edgeListGraph(false, [2]int{1, 1}, [2]int{2, 3}),
+			mode:        ExactSolver,
+			wantSize:    2,
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			mode:        ExactSolver,
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.MinimumVertexCover(test.mode)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !isVertexCover(test.input, got) {
+					t.Errorf("not a vertex cover: %v", got)
+				}
+				if len(got) != test.wantSize {
+					t.Errorf("%v != %v", len(got), test.wantSize)
+				}
+			}
+		})
+	}
+}
+
+func TestMinimumDominatingSet(t *testing.T) {
+	tests := []struct {
+		description string
+		input       Graph[int]
+		mode        SolverMode
+		wantSize    int
+		wantError   error
+	}{
+		{
+			description: "path exact",
+			input:       edgeListGraph(false, [2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{4, 5}, [2]int{5, 6}),
+			mode:        ExactSolver,
+			wantSize:    2,
+		},
+		{
+			description: "star approximate",
+			input:       edgeListGraph(false, [2]int{0, 1}, [2]int{0, 2}, [2]int{0, 3}, [2]int{0, 4}),
+			mode:        ApproximateSolver,
+			wantSize:    1,
+		},
+		{
+			description: "directed graph",
+			input:       edgeListGraph(true, [2]int{1, 2}),
+			mode:        ExactSolver,
+			wantError:   DirectedGraphErr{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.MinimumDominatingSet(test.mode)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !isDominatingSet(test.input, got) {
+					t.Errorf("not a dominating set: %v", got)
+				}
+				if len(got) != test.wantSize {
+					t.Errorf("%v != %v", len(got), test.wantSize)
+				}
+			}
+		})
+	}
+}
+
+func TestVertexSetsRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 30; i++ {
+		const n = 10
+		g := NewGraph[int](false)
+		_ = g.AddVertices(0, 1, 2, 3, 4, 5, 6, 7, 8, 9)
+		for j := 0; j < 15; j++ {
+			a, b := r.Intn(n), r.Intn(n)
+			if a != b {
+				_ = g.AddEdge(a, b, 1)
+			}
+		}
+
+		cover, err := g.MinimumVertexCover(ExactSolver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantCover := bruteForceMinimum(n, func(s []int) bool { return isVertexCover(g, s) })
+		if !isVertexCover(g, cover) || len(cover) != wantCover {
+			t.Errorf("graph %v: vertex cover %v, want size %v", g, cover, wantCover)
+		}
+
+		approx, err := g.MinimumVertexCover(ApproximateSolver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isVertexCover(g, approx) || len(approx) > 2*wantCover {
+			t.Errorf("graph %v: approximate vertex cover %v, want size <= %v", g, approx, 2*wantCover)
+		}
+
+		independent, err := g.MaximumIndependentSet(ExactSolver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !isIndependentSet(g, independent) || len(independent) != n-wantCover {
+			t.Errorf("graph %v: independent set %v, want size %v", g, independent, n-wantCover)
+		}
+
+		dominating, err := g.MinimumDominatingSet(ExactSolver)
+		if err != nil {
+			t.Fatal(err)
+		}
+		wantDominating := bruteForceMinimum(n, func(s []int) bool { return isDominatingSet(g, s) })
+		if !isDominatingSet(g, dominating) || len(dominating) != wantDominating {
+			t.Errorf("graph %v: dominating set %v, want size %v", g, dominating, wantDominating)
+		}
+	}
+}
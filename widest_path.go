@@ -0,0 +1,94 @@
+package graph
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/subpop/go-adt"
+)
+
+// WidestPath computes, from the source vertex to every other vertex, a path
+// that maximizes its width: the smallest edge weight along it, such as the
+// bandwidth of a route limited by its slowest link. It is a variant of
+// Dijkstra's algorithm that settles the widest vertex first. The Distance of
+// each PathResult is the width of the path. The source has a width of
+// math.Inf(1), since its path has no edges, and unreachable vertices have a
+// width of math.Inf(-1) and a nil Path. If the source vertex does not exist
+// in the graph, it returns MissingVertexErr.
+func (g *Graph[V]) WidestPath(source V) (map[V]PathResult[V], error) {
+	if _, ok := g.vertices[source]; !ok {
+		return nil, &MissingVertexErr[V]{v: source}
+	}
+
+	return g.maximizePaths(source, math.Inf(1), math.Inf(-1), math.Min), nil
+}
+
+// MostReliablePath computes, from the source vertex to every other vertex, a
+// path that maximizes the product of its edge weights, which are taken as the
+// independent probabilities that each edge works. The Distance of each
+// PathResult is the probability that the whole path works. The source has a
+// probability of 1, and unreachable vertices have a probability of 0 and a
+// nil Path. If the source vertex does not exist in the graph, it returns
+// MissingVertexErr. If an edge weight is not between 0 and 1, it returns
+// InvalidArgumentErr.
+func (g *Graph[V]) MostReliablePath(source V) (map[V]PathResult[V], error) {
+	if _, ok := g.vertices[source]; !ok {
+		return nil, &MissingVertexErr[V]{v: source}
+	}
+	for _, edges := range g.adjacencyMap {
+		for _, weight := range edges.Explicit {
+			if !(weight >= 0 && weight <= 1) {
+				return nil, InvalidArgumentErr{fmt.Sprintf("%v", weight), "edge weights must be probabilities between 0 and 1"}
+			}
+		}
+	}
+
+	return g.maximizePaths(source, 1, 0, func(p, q float64) float64 { return p * q }), nil
+}
+
+// maximizePaths runs Dijkstra's algorithm with the order reversed, settling
+// the vertex with the largest value first. The source has the value start,
+// and extending a path with value d along an edge of weight w gives
+// extend(d, w). This finds the best paths as long as extending a path never
+// increases its value. Unreachable vertices get the value unreachable.
+func (g *Graph[V]) maximizePaths(source V, start, unreachable float64, extend func(d, w float64) float64) map[V]PathResult[V] {
+	value := map[V]float64{source: start}
+	prev := make(map[V]*V)
+	visited := make(set[V])
+
+	// The priority queue pops the smallest priority first, so values are
+	// pushed negated.
+	pq := adt.NewPriorityQueue[V](0)
+	pq.Push(source, -start)
+	for pq.Len() > 0 {
+		u := *pq.Pop()
+		if visited[u] {
+			continue
+		}
+		visited[u] = true
+
+		for v, weight := range g.adjacencyMap[u].Explicit {
+			if visited[v] {
+				continue
+			}
+			alt := extend(value[u], weight)
+			if d, ok := value[v]; !ok || alt > d {
+				value[v] = alt
+				uCopy := u
+				prev[v] = &uCopy
+				pq.Push(v, -alt)
+			}
+		}
+	}
+
+	results := make(map[V]PathResult[V], len(g.vertices))
+	for v := range g.vertices {
+		if d, ok := value[v]; ok {
+			results[v] = PathResult[V]{Distance: d, Path: g.reconstructPath(prev, source, v)}
+		} else {
+			results[v] = PathResult[V]{Distance: unreachable, Path: nil}
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,200 @@
+package graph
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+func TestWidestPath(t *testing.T) {
+	network := NewGraph[string](false)
+	_ = network.AddEdge("a", "b", 5)
+	_ = network.AddEdge("b", "d", 3)
+	_ = network.AddEdge("a", "c", 2)
+	_ = network.AddEdge("c", "d", 10)
+	_ = network.AddEdge("d", "e", 4)
+	_ = network.AddVertex("f")
+
+	oneWay := NewGraph[string](true)
+	_ = oneWay.AddEdge("a", "b", 2)
+	_ = oneWay.AddEdge("b", "c", 7)
+
+	tests := []struct {
+		description string
+		input       Graph[string]
+		source      string
+		want        map[string]PathResult[string]
+		wantError   error
+	}{
+		{
+			description: "undirected",
+			input:       network,
+			source:      "a",
+			want: map[string]PathResult[string]{
+				"a": {Distance: math.Inf(1), Path: []string{"a"}},
+				"b": {Distance: 5, Path: []string{"a", "b"}},
+				"c": {Distance: 3, Path: []string{"a", "b", "d", "c"}},
+				"d": {Distance: 3, Path: []string{"a", "b", "d"}},
+				"e": {Distance: 3, Path: []string{"a", "b", "d", "e"}},
+				"f": {Distance: math.Inf(-1)},
+			},
+		},
+		{
+			description: "directed",
+			input:       oneWay,
+			source:      "b",
+			want: map[string]PathResult[string]{
+				"a": {Distance: math.Inf(-1)},
+				"b": {Distance: math.Inf(1), Path: []string{"b"}},
+				"c": {Distance: 7, Path: []string{"b", "c"}},
+			},
+		},
+		{
+			description: "missing vertex",
+			input:       network,
+			source:      "z",
+			wantError:   &MissingVertexErr[string]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.WidestPath(test.source)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestWidestPathRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 50; i++ {
+		g := randomWeightedGraph(r, i%2 == 0, 7, 0.3)
+		source := r.Intn(7)
+
+		got, err := g.WidestPath(source)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for target := 0; target < 7; target++ {
+			if target == source {
+				continue
+			}
+			want := math.Inf(-1)
+			paths, _ := g.AllSimplePaths(source, target, -1)
+			paths(func(path []int) bool {
+				want = math.Max(want, g.pathWidth(path))
+				return true
+			})
+
+			if got[target].Distance != want {
+				t.Fatalf("%v: %v to %v: %v != %v", g, source, target, got[target].Distance, want)
+			}
+			if !math.IsInf(want, -1) {
+				if !isPath(g, got[target].Path, source, target) || g.pathWidth(got[target].Path) != want {
+					t.Errorf("%v: %v is not a path of width %v from %v to %v", g, got[target].Path, want, source, target)
+				}
+			}
+		}
+	}
+}
+
+// pathWidth returns the smallest weight of the edges along path.
+func (g *Graph[V]) pathWidth(path []V) float64 {
+	width := math.Inf(1)
+	for i := 1; i < len(path); i++ {
+		width = math.Min(width, g.adjacencyMap[path[i-1]].Explicit[path[i]])
+	}
+	return width
+}
+
+func TestMostReliablePath(t *testing.T) {
+	links := NewGraph[string](true)
+	_ = links.AddEdge("s", "a", 0.75)
+	_ = links.AddEdge("a", "t", 0.75)
+	_ = links.AddEdge("s", "t", 0.5)
+	_ = links.AddEdge("s", "b", 0.25)
+	_ = links.AddEdge("b", "t", 1)
+	_ = links.AddVertex("u")
+
+	tests := []struct {
+		description string
+		input       Graph[string]
+		source      string
+		want        map[string]PathResult[string]
+		wantError   error
+	}{
+		{
+			description: "directed",
+			input:       links,
+			source:      "s",
+			want: map[string]PathResult[string]{
+				"s": {Distance: 1, Path: []string{"s"}},
+				"a": {Distance: 0.75, Path: []string{"s", "a"}},
+				"b": {Distance: 0.25, Path: []string{"s", "b"}},
+				"t": {Distance: 0.5625, Path: []string{"s", "a", "t"}},
+				"u": {Distance: 0},
+			},
+		},
+		{
+			description: "certain edges",
+			input:       edgeListGraph(false, [2]string{"a", "b"}, [2]string{"b", "c"}),
+			source:      "a",
+			want: map[string]PathResult[string]{
+				"a": {Distance: 1, Path: []string{"a"}},
+				"b": {Distance: 1, Path: []string{"a", "b"}},
+				"c": {Distance: 1, Path: []string{"a", "b", "c"}},
+			},
+		},
+		{
+			description: "weight above 1",
+			input: func() Graph[string] {
+				g := NewGraph[string](false)
+				_ = g.AddEdge("a", "b", 1.5)
+				return g
+			}(),
+			source:    "a",
+			wantError: InvalidArgumentErr{"1.5", "edge weights must be probabilities between 0 and 1"},
+		},
+		{
+			description: "missing vertex",
+			input:       links,
+			source:      "z",
+			wantError:   &MissingVertexErr[string]{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.description, func(t *testing.T) {
+			got, err := test.input.MostReliablePath(test.source)
+
+			if test.wantError != nil {
+				if !cmp.Equal(err, test.wantError, cmpopts.EquateErrors()) {
+					t.Errorf("%#v != %#v", err, test.wantError)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !cmp.Equal(got, test.want) {
+					t.Errorf("%v != %v", got, test.want)
+				}
+			}
+		})
+	}
+}